@@ -7,10 +7,8 @@ import (
 	"time"
 
 	"github.com/bahrunnur/loan-billing-service/internal/config"
-	"github.com/bahrunnur/loan-billing-service/internal/model"
 	v1 "github.com/bahrunnur/loan-billing-service/proto/gen/loanbilling/v1"
 	"github.com/caarlos0/env/v11"
-	"go.jetify.com/typeid"
 	"google.golang.org/grpc"
 )
 
@@ -60,12 +58,31 @@ func main() {
 	}
 	defer client.Close()
 
-	loanID, err := typeid.New[model.LoanID]()
+	createResp, err := client.Service.CreateLoan(ctx, &v1.CreateLoanRequest{
+		Principal:             &v1.Money{Amount: 5000000, Currency: "IDR"},
+		AnnualInterestRateBps: 1000,
+		Frequency:             v1.Frequency_FREQUENCY_WEEKLY,
+		LoanTerm:              50,
+	})
 	if err != nil {
-		log.Fatalf("fail to create loan type id: %v", err)
+		log.Fatalf("fail to request CreateLoan: %v", err)
 	}
+	loanID := createResp.Loan.Id
+	log.Printf("loanID: %s, installment amount: %d", loanID, createResp.Loan.InstallmentAmount.Amount)
 
-	req := &v1.IsDelinquentRequest{LoanId: loanID.String()}
+	scheduleResp, err := client.Service.GetBillingSchedule(ctx, &v1.GetBillingScheduleRequest{LoanId: loanID})
+	if err != nil {
+		log.Fatalf("fail to request GetBillingSchedule: %v", err)
+	}
+	log.Printf("loanID: %s, first due date: %s", loanID, scheduleResp.Billings[0].PaymentDueDate.AsTime())
+
+	quoteResp, err := client.Service.GetPayoffQuote(ctx, &v1.GetPayoffQuoteRequest{LoanId: loanID})
+	if err != nil {
+		log.Fatalf("fail to request GetPayoffQuote: %v", err)
+	}
+	log.Printf("loanID: %s, payoff amount today: %d", loanID, quoteResp.Quote.PayoffAmount.Amount)
+
+	req := &v1.IsDelinquentRequest{LoanId: loanID}
 	resp, err := client.Service.IsDelinquent(ctx, req)
 	if err != nil {
 		log.Fatalf("fail to request IsDelinquent: %v", err)
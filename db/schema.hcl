@@ -12,7 +12,7 @@ table "loan" {
   }
   column "principal" {
     null = false
-    type = integer
+    type = bigint
   }
   column "annual_interest_rate" {
     null = false
@@ -20,27 +20,36 @@ table "loan" {
   }
   column "total_interest" {
     null = false
-    type = integer
+    type = bigint
   }
   column "outstanding_balance" {
     null = false
-    type = integer
+    type = bigint
   }
   column "start_date" {
     null = false
     type = timestamptz
   }
-  column "loan_term_week" {
+  column "frequency" {
+    null    = false
+    type    = varchar(16)
+    default = "weekly"
+  }
+  column "first_due_date" { # null when one installment after the start date
+    null = true
+    type = timestamptz
+  }
+  column "loan_term" { # number of installments
     null = false
     type = integer
   }
-  column "weekly_payment" {
+  column "installment_amount" {
     null = false
-    type = integer
+    type = bigint
   }
-  column "weekly_interest" {
+  column "installment_interest" {
     null = false
-    type = integer
+    type = bigint
   }
   column "created_at" {
     null    = false
@@ -52,11 +61,137 @@ table "loan" {
     type    = boolean
     default = false
   }
+  column "allocation_policy" {
+    null    = false
+    type    = varchar(32)
+    default = "apply_to_future"
+  }
+  column "credit" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "interest_method" {
+    null    = false
+    type    = varchar(32)
+    default = "flat"
+  }
+  column "rate_basis" { # the loans before the rate basis were charged over the whole loan
+    null    = false
+    type    = varchar(16)
+    default = "per_tenor"
+  }
+  column "day_count" {
+    null    = false
+    type    = varchar(16)
+    default = "act_365"
+  }
+  column "product" {
+    null    = false
+    type    = varchar(64)
+    default = ""
+  }
+  column "missed_payment_threshold" { # the delinquency rule snapshotted at creation
+    null    = false
+    type    = integer
+    default = 1
+  }
+  column "delinquency_grace_days" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "terms_version" { # bumped by every restructure, the superseded terms are in loan_terms
+    null    = false
+    type    = integer
+    default = 1
+  }
+  column "written_off_at" { # null unless written off, the amounts are in write_off
+    null = true
+    type = timestamptz
+  }
   primary_key {
     columns = [column.id]
   }
 }
 
+table "loan_terms" { # the superseded versions of the loan terms, the current one is on the loan
+  schema = schema.billing
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "loan_id" {
+    null = false
+    type = uuid
+  }
+  column "version" {
+    null = false
+    type = integer
+  }
+  column "start_date" {
+    null = false
+    type = timestamptz
+  }
+  column "superseded_at" {
+    null = true
+    type = timestamptz
+  }
+  column "principal" {
+    null = false
+    type = bigint
+  }
+  column "annual_interest_rate" {
+    null = false
+    type = integer
+  }
+  column "frequency" {
+    null = false
+    type = varchar(16)
+  }
+  column "first_due_date" {
+    null = true
+    type = timestamptz
+  }
+  column "loan_term" {
+    null = false
+    type = integer
+  }
+  column "interest_method" {
+    null = false
+    type = varchar(32)
+  }
+  column "rate_basis" {
+    null = false
+    type = varchar(16)
+  }
+  column "day_count" {
+    null = false
+    type = varchar(16)
+  }
+  column "total_interest" {
+    null = false
+    type = bigint
+  }
+  column "installment_amount" {
+    null = false
+    type = bigint
+  }
+  index "loan_id_version" {
+    unique  = true
+    columns = [column.loan_id, column.version]
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "loan_id_fk_loan_terms" {
+    columns     = [column.loan_id]
+    ref_columns = [table.loan.column.id]
+    on_update   = NO_ACTION
+    on_delete   = CASCADE
+  }
+}
+
 table "delinquency_status" {
   schema = schema.billing
   column "id" {
@@ -74,20 +209,51 @@ table "delinquency_status" {
   }
   column "late_fee" {
     null = true
-    type = integer
+    type = bigint
   }
   column "last_payment_date" {
     null = true
     type = timestamptz
   }
   column "next_expected_payment_date" {
-    null = false
+    null = true
+    type = timestamptz
+  }
+  column "days_past_due" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "bucket" {
+    null    = false
+    type    = varchar(16)
+    default = "current"
+  }
+  column "kolektibilitas" { # OJK 1-5
+    null    = false
+    type    = smallint
+    default = 1
+  }
+  column "aged_at" {
+    null = true
+    type = timestamptz
+  }
+  column "delinquent_at" { # the latest transitions, see delinquency_event
+    null = true
+    type = timestamptz
+  }
+  column "cured_at" {
+    null = true
     type = timestamptz
   }
   index "loan_id" {
-    unique  = false
+    unique  = true
     columns = [column.loan_id]
   }
+  index "bucket" { # portfolio reports
+    unique  = false
+    columns = [column.bucket]
+  }
   primary_key {
     columns = [column.id]
   }
@@ -109,26 +275,72 @@ table "payment" {
     null = false
     type = uuid
   }
+  column "kind" { # a recovery is collected after the write-off, see model.PaymentKind
+    null    = false
+    type    = varchar(16)
+    default = "repayment"
+  }
   column "date" {
     null = false
     type = timestamptz
   }
   column "amount" {
     null = false
-    type = integer
+    type = bigint
   }
   column "balance_before" {
     null = false
-    type = integer
+    type = bigint
   }
   column "balance_after" {
     null = false
-    type = integer
+    type = bigint
+  }
+  column "principal" { # the components the payment has been applied to
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "interest" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "fee" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "penalty" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "rebate" { # the unearned interest given back by a settlement, not paid
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "reference" { # idempotency key of the client, null when not given
+    null = true
+    type = text
+  }
+  column "reversed_at" { # kept for the audit once reversed, see model.Payment
+    null = true
+    type = timestamptz
+  }
+  column "reversal_reason" {
+    null = true
+    type = text
   }
   index "loan_id" {
     unique  = false
     columns = [column.loan_id]
   }
+  index "payment_loan_id_reference" { # replays are matched by it, see model.ErrIdempotencyKeyConflict
+    unique  = true
+    columns = [column.loan_id, column.reference]
+  }
   primary_key {
     columns = [column.id]
   }
@@ -140,7 +352,7 @@ table "payment" {
   }
 }
 
-table "billing" {
+table "delinquency_event" { # every time the delinquency flips
   schema = schema.billing
   column "id" {
     null = false
@@ -150,30 +362,269 @@ table "billing" {
     null = false
     type = uuid
   }
-  column "term_number" {
+  column "kind" {
+    null = false
+    type = varchar(16)
+  }
+  column "at" {
+    null = false
+    type = timestamptz
+  }
+  column "missed_billings" {
     null = false
     type = integer
   }
-  column "payment_due_date" {
+  index "loan_id_at" {
+    unique  = false
+    columns = [column.loan_id, column.at]
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "loan_id_fk_delinquency_event" {
+    columns     = [column.loan_id]
+    ref_columns = [table.loan.column.id]
+    on_update   = NO_ACTION
+    on_delete   = CASCADE
+  }
+}
+
+table "deferral_event" { # every payment holiday given to a loan
+  schema = schema.billing
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "loan_id" {
+    null = false
+    type = uuid
+  }
+  column "at" {
     null = false
     type = timestamptz
   }
-  column "repayment" {
+  column "from_term" { # the first deferred installment
     null = false
     type = integer
   }
-  column "is_paid" {
+  column "periods" {
     null = false
-    type = boolean
-    default = false
+    type = integer
   }
-  index "loan_id" {
+  column "method" {
+    null = false
+    type = varchar(16)
+  }
+  column "deferred_amount" {
+    null = false
+    type = bigint
+  }
+  column "reason" {
+    null    = false
+    type    = text
+    default = ""
+  }
+  index "loan_id_at" {
     unique  = false
+    columns = [column.loan_id, column.at]
+  }
+  primary_key {
+    columns = [column.id]
+  }
+  foreign_key "loan_id_fk_deferral_event" {
+    columns     = [column.loan_id]
+    ref_columns = [table.loan.column.id]
+    on_update   = NO_ACTION
+    on_delete   = CASCADE
+  }
+}
+
+table "write_off" { # the charge-off of a loan, at most one per loan
+  schema = schema.billing
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "loan_id" {
+    null = false
+    type = uuid
+  }
+  column "at" {
+    null = false
+    type = timestamptz
+  }
+  column "principal" { # what was left to pay, written off
+    null = false
+    type = bigint
+  }
+  column "interest" {
+    null = false
+    type = bigint
+  }
+  column "fee" {
+    null = false
+    type = bigint
+  }
+  column "penalty" {
+    null = false
+    type = bigint
+  }
+  column "reason" {
+    null    = false
+    type    = text
+    default = ""
+  }
+  index "write_off_loan_id" {
+    unique  = true
     columns = [column.loan_id]
   }
   primary_key {
     columns = [column.id]
   }
+  foreign_key "loan_id_fk_write_off" {
+    columns     = [column.loan_id]
+    ref_columns = [table.loan.column.id]
+    on_update   = NO_ACTION
+    on_delete   = CASCADE
+  }
+}
+
+table "end_of_day_run" { # checkpoint of the end-of-day batch, one per business date
+  schema = schema.billing
+  column "run_key" {
+    null = false
+    type = varchar(16)
+  }
+  column "business_date" {
+    null = false
+    type = date
+  }
+  column "started_at" {
+    null = false
+    type = timestamptz
+  }
+  column "finished_at" {
+    null = true
+    type = timestamptz
+  }
+  column "checkpoint" { # the last swept loan, the active loans are swept by id
+    null = true
+    type = uuid
+  }
+  column "processed" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "delinquent" {
+    null    = false
+    type    = integer
+    default = 0
+  }
+  column "charged" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  primary_key {
+    columns = [column.run_key]
+  }
+}
+
+table "billing" {
+  schema = schema.billing
+  column "id" {
+    null = false
+    type = uuid
+  }
+  column "loan_id" {
+    null = false
+    type = uuid
+  }
+  column "terms_version" { # the version of the loan terms that scheduled it
+    null    = false
+    type    = integer
+    default = 1
+  }
+  column "term_number" {
+    null = false
+    type = integer
+  }
+  column "payment_due_date" {
+    null = false
+    type = timestamptz
+  }
+  column "repayment" { # principal + interest + fee + penalty
+    null = false
+    type = bigint
+  }
+  column "principal" {
+    null = false
+    type = bigint
+  }
+  column "interest" {
+    null = false
+    type = bigint
+  }
+  column "fee" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "penalty" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "paid_amount" { # paid principal + interest + fee + penalty
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "paid_principal" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "paid_interest" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "paid_fee" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "paid_penalty" {
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "rebate" { # the unearned interest given back by a settlement, not paid
+    null    = false
+    type    = bigint
+    default = 0
+  }
+  column "accrued_until" { # late charges accrued up to
+    null = true
+    type = timestamptz
+  }
+  column "superseded_at" { # closed unpaid by a restructure
+    null = true
+    type = timestamptz
+  }
+  column "deferred_at" { # a payment holiday, never missed
+    null = true
+    type = timestamptz
+  }
+  index "loan_id_term_number" {
+    unique  = true
+    columns = [column.loan_id, column.terms_version, column.term_number]
+  }
+  primary_key {
+    columns = [column.id]
+  }
   foreign_key "loan_id_fk_payment" {
     columns     = [column.loan_id]
     ref_columns = [table.loan.column.id]
@@ -0,0 +1,173 @@
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"google.golang.org/grpc/codes"
+)
+
+// Domain is the domain of every error reason returned by this service (see google.rpc.ErrorInfo)
+const Domain = "loanbilling.bahrunnur.github.com"
+
+// Stable error reasons for the clients to switch on, never rename these
+const (
+	ReasonInternal = "INTERNAL"
+
+	ReasonInvalidLoanID       = "INVALID_LOAN_ID"
+	ReasonInvalidPaymentID    = "INVALID_PAYMENT_ID"
+	ReasonInvalidPaymentTime  = "INVALID_PAYMENT_TIME"
+	ReasonUnsupportedCurrency = "UNSUPPORTED_CURRENCY"
+	ReasonMalformedRequest    = "MALFORMED_REQUEST"
+
+	ReasonLoanNotFound              = "LOAN_NOT_FOUND"
+	ReasonPaymentNotFound           = "PAYMENT_NOT_FOUND"
+	ReasonDelinquencyStatusNotFound = "DELINQUENCY_STATUS_NOT_FOUND"
+	ReasonWriteOffNotFound          = "WRITE_OFF_NOT_FOUND"
+
+	ReasonNegativeInterest         = "NEGATIVE_INTEREST"
+	ReasonNoPrincipal              = "NO_PRINCIPAL"
+	ReasonNoTerm                   = "NO_TERM"
+	ReasonPaymentAmountMismatch    = "PAYMENT_AMOUNT_MISMATCH"
+	ReasonFutureDelinquencyCheck   = "FUTURE_DELINQUENCY_CHECK"
+	ReasonLoanDelinquent           = "LOAN_DELINQUENT"
+	ReasonLoanRepaymentCompleted   = "LOAN_REPAYMENT_COMPLETED"
+	ReasonNonPositivePayment       = "NON_POSITIVE_PAYMENT"
+	ReasonOverpayOutstanding       = "OVERPAY_OUTSTANDING"
+	ReasonUnknownAllocationPolicy  = "UNKNOWN_ALLOCATION_POLICY"
+	ReasonPayoffAmountMismatch     = "PAYOFF_AMOUNT_MISMATCH"
+	ReasonUnknownFrequency         = "UNKNOWN_FREQUENCY"
+	ReasonUnknownInterestMethod    = "UNKNOWN_INTEREST_METHOD"
+	ReasonUnknownRateBasis         = "UNKNOWN_RATE_BASIS"
+	ReasonUnknownDayCount          = "UNKNOWN_DAY_COUNT"
+	ReasonStartDateOutOfWindow     = "START_DATE_OUT_OF_WINDOW"
+	ReasonInvalidFirstDueDate      = "INVALID_FIRST_DUE_DATE"
+	ReasonIdempotencyKeyConflict   = "IDEMPOTENCY_KEY_CONFLICT"
+	ReasonPaymentReversed          = "PAYMENT_ALREADY_REVERSED"
+	ReasonNoReversalReason         = "NO_REVERSAL_REASON"
+	ReasonReversalBeforePayment    = "REVERSAL_BEFORE_PAYMENT"
+	ReasonInvalidRestructureDate   = "INVALID_RESTRUCTURE_DATE"
+	ReasonPaymentBeforeRestructure = "PAYMENT_BEFORE_RESTRUCTURE"
+	ReasonNoDeferralPeriod         = "NO_DEFERRAL_PERIOD"
+	ReasonUnknownDeferralMethod    = "UNKNOWN_DEFERRAL_METHOD"
+	ReasonInvalidDeferralDate      = "INVALID_DEFERRAL_DATE"
+	ReasonNothingToDefer           = "NOTHING_TO_DEFER"
+	ReasonLoanWrittenOff           = "LOAN_WRITTEN_OFF"
+	ReasonInvalidWriteOffDate      = "INVALID_WRITE_OFF_DATE"
+	ReasonRecoveryBeforeWriteOff   = "RECOVERY_BEFORE_WRITE_OFF"
+	ReasonBusinessDayNotOver       = "BUSINESS_DAY_NOT_OVER"
+	ReasonEndOfDayRunInProgress    = "END_OF_DAY_RUN_IN_PROGRESS"
+)
+
+// errors raised by the adapters while decoding a request, before reaching the domain
+var (
+	ErrInvalidLoanID       = errors.New("invalid loan id")
+	ErrInvalidPaymentID    = errors.New("invalid payment id")
+	ErrInvalidPaymentTime  = errors.New("invalid payment time")
+	ErrUnsupportedCurrency = errors.New("unsupported currency")
+	ErrMalformedRequest    = errors.New("malformed request")
+)
+
+// Error is an error translated for the API clients
+type Error struct {
+	Code    codes.Code
+	Reason  string
+	Message string
+}
+
+// HTTPStatus maps the gRPC code into HTTP status (ref: https://github.com/googleapis/googleapis/blob/master/google/rpc/code.proto)
+func (e Error) HTTPStatus() int {
+	switch e.Code {
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+var translations = []struct {
+	err    error
+	code   codes.Code
+	reason string
+}{
+	{ErrInvalidLoanID, codes.InvalidArgument, ReasonInvalidLoanID},
+	{ErrInvalidPaymentID, codes.InvalidArgument, ReasonInvalidPaymentID},
+	{ErrInvalidPaymentTime, codes.InvalidArgument, ReasonInvalidPaymentTime},
+	{ErrUnsupportedCurrency, codes.InvalidArgument, ReasonUnsupportedCurrency},
+	{ErrMalformedRequest, codes.InvalidArgument, ReasonMalformedRequest},
+
+	{model.ErrLoanNotFound, codes.NotFound, ReasonLoanNotFound},
+	{model.ErrPaymentNotFound, codes.NotFound, ReasonPaymentNotFound},
+	{model.ErrDelinquencyStatusNotFound, codes.NotFound, ReasonDelinquencyStatusNotFound},
+	{model.ErrWriteOffNotFound, codes.NotFound, ReasonWriteOffNotFound},
+
+	{model.ErrNegativeInterest, codes.InvalidArgument, ReasonNegativeInterest},
+	{model.ErrNoPrincipal, codes.InvalidArgument, ReasonNoPrincipal},
+	{model.ErrNoTerm, codes.InvalidArgument, ReasonNoTerm},
+	{model.ErrMismatchPayment, codes.InvalidArgument, ReasonPaymentAmountMismatch},
+	{model.ErrCheckFutureDelinquent, codes.InvalidArgument, ReasonFutureDelinquencyCheck},
+	{model.ErrNonPositivePayment, codes.InvalidArgument, ReasonNonPositivePayment},
+	{model.ErrOverpayOutstanding, codes.InvalidArgument, ReasonOverpayOutstanding},
+	{model.ErrUnknownPolicy, codes.InvalidArgument, ReasonUnknownAllocationPolicy},
+	{model.ErrMismatchPayoff, codes.InvalidArgument, ReasonPayoffAmountMismatch},
+	{model.ErrUnknownFrequency, codes.InvalidArgument, ReasonUnknownFrequency},
+	{model.ErrUnknownInterestMethod, codes.InvalidArgument, ReasonUnknownInterestMethod},
+	{model.ErrUnknownRateBasis, codes.InvalidArgument, ReasonUnknownRateBasis},
+	{model.ErrUnknownDayCount, codes.InvalidArgument, ReasonUnknownDayCount},
+	{model.ErrStartDateOutOfWindow, codes.InvalidArgument, ReasonStartDateOutOfWindow},
+	{model.ErrInvalidFirstDueDate, codes.InvalidArgument, ReasonInvalidFirstDueDate},
+	{model.ErrNoReversalReason, codes.InvalidArgument, ReasonNoReversalReason},
+	{model.ErrReversalBeforePayment, codes.InvalidArgument, ReasonReversalBeforePayment},
+	{model.ErrInvalidRestructureDate, codes.InvalidArgument, ReasonInvalidRestructureDate},
+	{model.ErrNoDeferralPeriod, codes.InvalidArgument, ReasonNoDeferralPeriod},
+	{model.ErrUnknownDeferralMethod, codes.InvalidArgument, ReasonUnknownDeferralMethod},
+	{model.ErrInvalidDeferralDate, codes.InvalidArgument, ReasonInvalidDeferralDate},
+	{model.ErrInvalidWriteOffDate, codes.InvalidArgument, ReasonInvalidWriteOffDate},
+	{model.ErrRecoveryBeforeWriteOff, codes.InvalidArgument, ReasonRecoveryBeforeWriteOff},
+	{model.ErrBusinessDayNotOver, codes.InvalidArgument, ReasonBusinessDayNotOver},
+
+	{model.ErrIdempotencyKeyConflict, codes.AlreadyExists, ReasonIdempotencyKeyConflict},
+
+	{model.ErrEndOfDayRunInProgress, codes.Aborted, ReasonEndOfDayRunInProgress},
+
+	{model.ErrPayInDelinquent, codes.FailedPrecondition, ReasonLoanDelinquent},
+	{model.ErrRepaymentComplete, codes.FailedPrecondition, ReasonLoanRepaymentCompleted},
+	{model.ErrPaymentReversed, codes.FailedPrecondition, ReasonPaymentReversed},
+	{model.ErrReversalAcrossRestructure, codes.FailedPrecondition, ReasonPaymentBeforeRestructure},
+	{model.ErrNothingToDefer, codes.FailedPrecondition, ReasonNothingToDefer},
+	{model.ErrLoanWrittenOff, codes.FailedPrecondition, ReasonLoanWrittenOff},
+}
+
+// From translates an error into an API error, anything unknown is reported as internal without leaking the details
+func From(err error) Error {
+	for _, t := range translations {
+		if errors.Is(err, t.err) {
+			return Error{
+				Code:    t.code,
+				Reason:  t.reason,
+				Message: err.Error(),
+			}
+		}
+	}
+
+	return Error{
+		Code:    codes.Internal,
+		Reason:  ReasonInternal,
+		Message: "internal error",
+	}
+}
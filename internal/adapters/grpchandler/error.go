@@ -0,0 +1,23 @@
+package grpchandler
+
+import (
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/apierror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// statusFrom translates an error into a gRPC status with google.rpc.ErrorInfo carrying the stable reason
+func statusFrom(err error) error {
+	apiErr := apierror.From(err)
+
+	st := status.New(apiErr.Code, apiErr.Message)
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: apiErr.Reason,
+		Domain: apierror.Domain,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -2,40 +2,72 @@ package grpchandler
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/apierror"
 	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock"
 	"github.com/bahrunnur/loan-billing-service/pkg/currency"
 	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
 	v1 "github.com/bahrunnur/loan-billing-service/proto/gen/loanbilling/v1"
 	"go.jetify.com/typeid"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type LoanBillingService interface {
-	GetLoan(loanID model.LoanID) (model.WeeklyLoanFullInformation, error)
+	CreateLoan(param model.LoanParam) (model.InstallmentLoan, error)
+	GetLoan(loanID model.LoanID) (model.LoanFullInformation, error)
+	GetBillingSchedule(loanID model.LoanID) ([]model.Billing, error)
 	CheckDelinquency(loanID model.LoanID, when time.Time) (bool, error)
-	RecordPayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) error
+	GetDelinquencyHistory(loanID model.LoanID) ([]model.DelinquencyEvent, error)
+	MakePayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah, reference string) (model.Payment, error)
+	ReversePayment(loanID model.LoanID, paymentID model.PaymentID, when time.Time, reason string) (model.Payment, error)
+	QuotePayoff(loanID model.LoanID, at time.Time) (model.PayoffQuote, error)
+	SettleLoan(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) (model.Payment, error)
+	AgeLoan(loanID model.LoanID, asOf time.Time) (model.Aging, error)
+	RestructureLoan(loanID model.LoanID, param model.RestructureParam) (model.InstallmentLoan, error)
+	GetTermsHistory(loanID model.LoanID) ([]model.LoanTerms, error)
+	DeferInstallments(loanID model.LoanID, param model.DeferralParam) (model.DeferralEvent, error)
+	GetDeferralHistory(loanID model.LoanID) ([]model.DeferralEvent, error)
+	WriteOffLoan(loanID model.LoanID, param model.WriteOffParam) (model.WriteOff, error)
+	GetWriteOffReport(loanID model.LoanID) (model.WriteOffReport, error)
 }
 
 type LoanBillingGRPCServer struct {
-	svc LoanBillingService
+	svc   LoanBillingService
+	clock ports.Clock
 	v1.UnimplementedLoanBillingServiceServer
 }
 
-func NewLoanBillingGRPCServer(svc LoanBillingService) *LoanBillingGRPCServer {
-	return &LoanBillingGRPCServer{svc: svc}
+// Option configures the LoanBillingGRPCServer
+type Option func(*LoanBillingGRPCServer)
+
+// WithClock sets the time of the requests without one (default: the wall clock)
+func WithClock(clock ports.Clock) Option {
+	return func(s *LoanBillingGRPCServer) {
+		s.clock = clock
+	}
+}
+
+func NewLoanBillingGRPCServer(svc LoanBillingService, opts ...Option) *LoanBillingGRPCServer {
+	s := &LoanBillingGRPCServer{svc: svc, clock: clock.Wall{}}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
 func (s *LoanBillingGRPCServer) GetOutstanding(ctx context.Context, req *v1.GetOutstandingRequest) (*v1.GetOutstandingResponse, error) {
 	logger := o11y.LoggerFromContext(ctx)
 
-	loanID, err := typeid.Parse[model.LoanID](req.LoanId)
+	loanID, err := parseLoanID(logger, req.LoanId)
 	if err != nil {
-		logger.Error("fail to parse loan id",
-			zap.String("requested_loan_id", req.LoanId),
-		)
-		return nil, err
+		return nil, statusFrom(err)
 	}
 
 	loan, err := s.svc.GetLoan(loanID)
@@ -43,6 +75,7 @@ func (s *LoanBillingGRPCServer) GetOutstanding(ctx context.Context, req *v1.GetO
 		logger.Error("fail to get outstanding balance",
 			zap.Error(err),
 		)
+		return nil, statusFrom(err)
 	}
 
 	return outstandingResponseFrom(loan), nil
@@ -51,33 +84,386 @@ func (s *LoanBillingGRPCServer) GetOutstanding(ctx context.Context, req *v1.GetO
 func (s *LoanBillingGRPCServer) IsDelinquent(ctx context.Context, req *v1.IsDelinquentRequest) (*v1.IsDelinquentResponse, error) {
 	logger := o11y.LoggerFromContext(ctx)
 
-	loanID, err := typeid.Parse[model.LoanID](req.LoanId)
+	loanID, err := parseLoanID(logger, req.LoanId)
 	if err != nil {
-		logger.Error("fail to parse loan id",
-			zap.String("requested_loan_id", req.LoanId),
-		)
-		return nil, err
+		return nil, statusFrom(err)
 	}
 
-	isDelinquent, err := s.svc.CheckDelinquency(loanID, time.Now().UTC())
+	isDelinquent, err := s.svc.CheckDelinquency(loanID, s.clock.Now().UTC())
 	if err != nil {
 		logger.Error("fail to get delinquency status",
 			zap.Error(err),
 		)
+		return nil, statusFrom(err)
 	}
 
 	return isDelinquentResponseFrom(isDelinquent), nil
 }
 
+func (s *LoanBillingGRPCServer) GetDelinquencyHistory(ctx context.Context, req *v1.GetDelinquencyHistoryRequest) (*v1.GetDelinquencyHistoryResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	events, err := s.svc.GetDelinquencyHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get delinquency history",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return delinquencyHistoryResponseFrom(events), nil
+}
+
 func (s *LoanBillingGRPCServer) MakePayment(ctx context.Context, req *v1.MakePaymentRequest) (*v1.MakePaymentResponse, error) {
 	logger := o11y.LoggerFromContext(ctx)
 
-	loanID, err := typeid.Parse[model.LoanID](req.LoanId)
+	loanID, err := parseLoanID(logger, req.LoanId)
 	if err != nil {
-		logger.Error("fail to parse loan id",
-			zap.String("requested_loan_id", req.LoanId),
+		return nil, statusFrom(err)
+	}
+
+	err = req.When.CheckValid()
+	if err != nil {
+		logger.Error("invalid payment time",
+			zap.Error(err),
+		)
+		return nil, statusFrom(fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
+	}
+
+	amount, err := parseMoney(logger, req.Amount, req.Decimal, req.Currency)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	payment, err := s.svc.MakePayment(loanID, req.When.AsTime(), amount, req.Reference)
+	if err != nil {
+		logger.Error("fail to make payment",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return &v1.MakePaymentResponse{Payment: paymentFrom(payment)}, nil
+}
+
+func (s *LoanBillingGRPCServer) ReversePayment(ctx context.Context, req *v1.ReversePaymentRequest) (*v1.ReversePaymentResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	paymentID, err := parsePaymentID(logger, req.PaymentId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	when, err := parseOptionalTime(logger, req.When)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+	if when.IsZero() {
+		when = s.clock.Now().UTC()
+	}
+
+	payment, err := s.svc.ReversePayment(loanID, paymentID, when, req.Reason)
+	if err != nil {
+		logger.Error("fail to reverse payment",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return &v1.ReversePaymentResponse{Payment: paymentFrom(payment)}, nil
+}
+
+func (s *LoanBillingGRPCServer) CreateLoan(ctx context.Context, req *v1.CreateLoanRequest) (*v1.CreateLoanResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	principal, err := parseMoney(logger, req.Principal.GetAmount(), req.Principal.GetDecimal(), req.Principal.GetCurrency())
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	frequency := frequencyTo(req.Frequency)
+
+	loanTerm := req.LoanTerm
+	if loanTerm == 0 && (frequency == "" || frequency == model.FrequencyWeekly) {
+		loanTerm = req.LoanTermWeeks // legacy weekly only request
+	}
+
+	startDate, err := parseOptionalTime(logger, req.StartDate)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	firstDueDate, err := parseOptionalTime(logger, req.FirstDueDate)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	loan, err := s.svc.CreateLoan(model.LoanParam{
+		Principal:          principal,
+		AnnualInterestRate: model.BPS(req.AnnualInterestRateBps),
+		Frequency:          frequency,
+		LoanTerm:           int(loanTerm),
+		AllocationPolicy:   allocationPolicyTo(req.AllocationPolicy),
+		InterestMethod:     interestMethodTo(req.InterestMethod),
+		RateBasis:          rateBasisTo(req.RateBasis),
+		DayCount:           dayCountTo(req.DayCount),
+		Product:            req.Product,
+		StartDate:          startDate,
+		FirstDueDate:       firstDueDate,
+	})
+	if err != nil {
+		logger.Error("fail to create loan",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return createLoanResponseFrom(loan), nil
+}
+
+func (s *LoanBillingGRPCServer) RestructureLoan(ctx context.Context, req *v1.RestructureLoanRequest) (*v1.RestructureLoanResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	firstDueDate, err := parseOptionalTime(logger, req.FirstDueDate)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	when, err := parseOptionalTime(logger, req.When)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+	if when.IsZero() {
+		when = s.clock.Now().UTC()
+	}
+
+	loan, err := s.svc.RestructureLoan(loanID, model.RestructureParam{
+		When:               when,
+		LoanTerm:           int(req.LoanTerm),
+		AnnualInterestRate: model.BPS(req.AnnualInterestRateBps),
+		Frequency:          frequencyTo(req.Frequency),
+		FirstDueDate:       firstDueDate,
+		CapitalizeArrears:  req.CapitalizeArrears,
+	})
+	if err != nil {
+		logger.Error("fail to restructure loan",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return restructureLoanResponseFrom(loan), nil
+}
+
+func (s *LoanBillingGRPCServer) GetLoanTermsHistory(ctx context.Context, req *v1.GetLoanTermsHistoryRequest) (*v1.GetLoanTermsHistoryResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	terms, err := s.svc.GetTermsHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get loan terms history",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return termsHistoryResponseFrom(terms), nil
+}
+
+func (s *LoanBillingGRPCServer) DeferInstallments(ctx context.Context, req *v1.DeferInstallmentsRequest) (*v1.DeferInstallmentsResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	when, err := parseOptionalTime(logger, req.When)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+	if when.IsZero() {
+		when = s.clock.Now().UTC()
+	}
+
+	event, err := s.svc.DeferInstallments(loanID, model.DeferralParam{
+		When:    when,
+		Periods: int(req.Periods),
+		Method:  deferralMethodTo(req.Method),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		logger.Error("fail to defer installments",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return deferInstallmentsResponseFrom(event), nil
+}
+
+func (s *LoanBillingGRPCServer) GetDeferralHistory(ctx context.Context, req *v1.GetDeferralHistoryRequest) (*v1.GetDeferralHistoryResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	events, err := s.svc.GetDeferralHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get deferral history",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return deferralHistoryResponseFrom(events), nil
+}
+
+func (s *LoanBillingGRPCServer) WriteOffLoan(ctx context.Context, req *v1.WriteOffLoanRequest) (*v1.WriteOffLoanResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	when, err := parseOptionalTime(logger, req.When)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+	if when.IsZero() {
+		when = s.clock.Now().UTC()
+	}
+
+	writeOff, err := s.svc.WriteOffLoan(loanID, model.WriteOffParam{
+		When:   when,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		logger.Error("fail to write off loan",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return writeOffLoanResponseFrom(writeOff), nil
+}
+
+func (s *LoanBillingGRPCServer) GetWriteOff(ctx context.Context, req *v1.GetWriteOffRequest) (*v1.GetWriteOffResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	report, err := s.svc.GetWriteOffReport(loanID)
+	if err != nil {
+		logger.Error("fail to get write-off",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return writeOffResponseFrom(report), nil
+}
+
+func (s *LoanBillingGRPCServer) GetLoan(ctx context.Context, req *v1.GetLoanRequest) (*v1.GetLoanResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	loan, err := s.svc.GetLoan(loanID)
+	if err != nil {
+		logger.Error("fail to get loan",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return getLoanResponseFrom(loan), nil
+}
+
+func (s *LoanBillingGRPCServer) GetBillingSchedule(ctx context.Context, req *v1.GetBillingScheduleRequest) (*v1.GetBillingScheduleResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	billings, err := s.svc.GetBillingSchedule(loanID)
+	if err != nil {
+		logger.Error("fail to get billing schedule",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return billingScheduleResponseFrom(billings), nil
+}
+
+func (s *LoanBillingGRPCServer) GetPayoffQuote(ctx context.Context, req *v1.GetPayoffQuoteRequest) (*v1.GetPayoffQuoteResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	at := s.clock.Now().UTC()
+	if req.At != nil {
+		err = req.At.CheckValid()
+		if err != nil {
+			logger.Error("invalid quote time",
+				zap.Error(err),
+			)
+			return nil, statusFrom(fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
+		}
+		at = req.At.AsTime()
+	}
+
+	quote, err := s.svc.QuotePayoff(loanID, at)
+	if err != nil {
+		logger.Error("fail to quote payoff",
+			zap.Error(err),
 		)
-		return nil, err
+		return nil, statusFrom(err)
+	}
+
+	return payoffQuoteResponseFrom(quote), nil
+}
+
+func (s *LoanBillingGRPCServer) SettleLoan(ctx context.Context, req *v1.SettleLoanRequest) (*v1.SettleLoanResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
+	if err != nil {
+		return nil, statusFrom(err)
 	}
 
 	err = req.When.CheckValid()
@@ -85,23 +471,107 @@ func (s *LoanBillingGRPCServer) MakePayment(ctx context.Context, req *v1.MakePay
 		logger.Error("invalid payment time",
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, statusFrom(fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
 	}
 
-	amount := currency.NewRupiah(int(req.Amount), int(req.Decimal))
-	if req.Currency != amount.ISOCode() {
-		logger.Error("mismatch currency",
-			zap.String("requested_currency", req.Currency),
+	amount, err := parseMoney(logger, req.Amount.GetAmount(), req.Amount.GetDecimal(), req.Amount.GetCurrency())
+	if err != nil {
+		return nil, statusFrom(err)
+	}
+
+	payment, err := s.svc.SettleLoan(loanID, req.When.AsTime(), amount)
+	if err != nil {
+		logger.Error("fail to settle loan",
+			zap.Error(err),
 		)
-		return nil, err
+		return nil, statusFrom(err)
 	}
 
-	err = s.svc.RecordPayment(loanID, req.When.AsTime(), amount)
+	return &v1.SettleLoanResponse{
+		Payment: paymentFrom(payment),
+	}, nil
+}
+
+func (s *LoanBillingGRPCServer) GetAging(ctx context.Context, req *v1.GetAgingRequest) (*v1.GetAgingResponse, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	loanID, err := parseLoanID(logger, req.LoanId)
 	if err != nil {
-		logger.Error("fail to make payment",
+		return nil, statusFrom(err)
+	}
+
+	at := s.clock.Now().UTC()
+	if req.At != nil {
+		err = req.At.CheckValid()
+		if err != nil {
+			logger.Error("invalid aging time",
+				zap.Error(err),
+			)
+			return nil, statusFrom(fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
+		}
+		at = req.At.AsTime()
+	}
+
+	aging, err := s.svc.AgeLoan(loanID, at)
+	if err != nil {
+		logger.Error("fail to age loan",
+			zap.Error(err),
+		)
+		return nil, statusFrom(err)
+	}
+
+	return agingResponseFrom(aging), nil
+}
+
+func parseLoanID(logger *zap.Logger, requested string) (model.LoanID, error) {
+	loanID, err := typeid.Parse[model.LoanID](requested)
+	if err != nil {
+		logger.Error("fail to parse loan id",
+			zap.String("requested_loan_id", requested),
+		)
+		return model.LoanID{}, fmt.Errorf("%w: %w", apierror.ErrInvalidLoanID, err)
+	}
+
+	return loanID, nil
+}
+
+func parsePaymentID(logger *zap.Logger, requested string) (model.PaymentID, error) {
+	paymentID, err := typeid.Parse[model.PaymentID](requested)
+	if err != nil {
+		logger.Error("fail to parse payment id",
+			zap.String("requested_payment_id", requested),
+		)
+		return model.PaymentID{}, fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentID, err)
+	}
+
+	return paymentID, nil
+}
+
+// parseOptionalTime gives the zero time for an unset timestamp
+func parseOptionalTime(logger *zap.Logger, requested *timestamppb.Timestamp) (time.Time, error) {
+	if requested == nil {
+		return time.Time{}, nil
+	}
+
+	err := requested.CheckValid()
+	if err != nil {
+		logger.Error("invalid time",
 			zap.Error(err),
 		)
+		return time.Time{}, fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err)
+	}
+
+	return requested.AsTime(), nil
+}
+
+func parseMoney(logger *zap.Logger, amount int64, decimal int32, requestedCurrency string) (currency.Rupiah, error) {
+	money := currency.NewRupiah(int(amount), int(decimal))
+	if requestedCurrency != money.ISOCode() {
+		logger.Error("mismatch currency",
+			zap.String("requested_currency", requestedCurrency),
+		)
+		return 0, fmt.Errorf("%w: %q", apierror.ErrUnsupportedCurrency, requestedCurrency)
 	}
 
-	return &v1.MakePaymentResponse{}, nil
+	return money, nil
 }
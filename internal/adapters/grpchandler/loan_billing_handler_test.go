@@ -0,0 +1,211 @@
+package grpchandler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/apierror"
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/grpchandler"
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	v1 "github.com/bahrunnur/loan-billing-service/proto/gen/loanbilling/v1"
+	. "github.com/onsi/gomega"
+	"go.jetify.com/typeid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestErrorTranslation(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+	server := grpchandler.NewLoanBillingGRPCServer(loanService)
+
+	created, err := server.CreateLoan(ctx, &v1.CreateLoanRequest{
+		Principal:             &v1.Money{Amount: 1000000, Currency: "IDR"},
+		AnnualInterestRateBps: 1000,
+		LoanTermWeeks:         10,
+		AllocationPolicy:      v1.AllocationPolicy_ALLOCATION_POLICY_EXACT_DUE,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(created.Loan.AllocationPolicy).To(Equal(v1.AllocationPolicy_ALLOCATION_POLICY_EXACT_DUE))
+
+	randoID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	randoPaymentID, err := typeid.New[model.PaymentID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	startDate := created.Loan.StartDate.AsTime()
+
+	testCases := []struct {
+		name           string
+		call           func() error
+		expectedCode   codes.Code
+		expectedReason string
+	}{
+		{
+			name: "Invalid Loan ID",
+			call: func() error {
+				_, err := server.GetOutstanding(ctx, &v1.GetOutstandingRequest{LoanId: "not-a-loan"})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonInvalidLoanID,
+		},
+		{
+			name: "Loan Not Found - Outstanding",
+			call: func() error {
+				_, err := server.GetOutstanding(ctx, &v1.GetOutstandingRequest{LoanId: randoID.String()})
+				return err
+			},
+			expectedCode:   codes.NotFound,
+			expectedReason: apierror.ReasonLoanNotFound,
+		},
+		{
+			name: "Loan Not Found - Delinquency",
+			call: func() error {
+				_, err := server.IsDelinquent(ctx, &v1.IsDelinquentRequest{LoanId: randoID.String()})
+				return err
+			},
+			expectedCode:   codes.NotFound,
+			expectedReason: apierror.ReasonLoanNotFound,
+		},
+		{
+			name: "No Term",
+			call: func() error {
+				_, err := server.CreateLoan(ctx, &v1.CreateLoanRequest{
+					Principal:             &v1.Money{Amount: 1000000, Currency: "IDR"},
+					AnnualInterestRateBps: 1000,
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonNoTerm,
+		},
+		{
+			name: "Mismatch Currency",
+			call: func() error {
+				_, err := server.MakePayment(ctx, &v1.MakePaymentRequest{
+					LoanId:   created.Loan.Id,
+					Amount:   110000,
+					Currency: "USD",
+					When:     timestamppb.New(startDate.AddDate(0, 0, 2)),
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonUnsupportedCurrency,
+		},
+		{
+			name: "Mismatch Payment",
+			call: func() error {
+				_, err := server.MakePayment(ctx, &v1.MakePaymentRequest{
+					LoanId:   created.Loan.Id,
+					Amount:   1,
+					Currency: "IDR",
+					When:     timestamppb.New(startDate.AddDate(0, 0, 2)),
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonPaymentAmountMismatch,
+		},
+		{
+			name: "Invalid Payment ID",
+			call: func() error {
+				_, err := server.ReversePayment(ctx, &v1.ReversePaymentRequest{
+					LoanId:    created.Loan.Id,
+					PaymentId: randoID.String(),
+					Reason:    "bounced",
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonInvalidPaymentID,
+		},
+		{
+			name: "Payment Not Found",
+			call: func() error {
+				_, err := server.ReversePayment(ctx, &v1.ReversePaymentRequest{
+					LoanId:    created.Loan.Id,
+					PaymentId: randoPaymentID.String(),
+					Reason:    "bounced",
+				})
+				return err
+			},
+			expectedCode:   codes.NotFound,
+			expectedReason: apierror.ReasonPaymentNotFound,
+		},
+		{
+			name: "Restructure Before the Start",
+			call: func() error {
+				_, err := server.RestructureLoan(ctx, &v1.RestructureLoanRequest{
+					LoanId:                created.Loan.Id,
+					LoanTerm:              20,
+					AnnualInterestRateBps: 800,
+					When:                  timestamppb.New(startDate.AddDate(0, 0, -1)),
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonInvalidRestructureDate,
+		},
+		{
+			name: "Defer Nothing",
+			call: func() error {
+				_, err := server.DeferInstallments(ctx, &v1.DeferInstallmentsRequest{
+					LoanId: created.Loan.Id,
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonNoDeferralPeriod,
+		},
+		{
+			name: "Not Written Off",
+			call: func() error {
+				_, err := server.GetWriteOff(ctx, &v1.GetWriteOffRequest{
+					LoanId: created.Loan.Id,
+				})
+				return err
+			},
+			expectedCode:   codes.NotFound,
+			expectedReason: apierror.ReasonWriteOffNotFound,
+		},
+		{
+			name: "Mismatch Payoff",
+			call: func() error {
+				_, err := server.SettleLoan(ctx, &v1.SettleLoanRequest{
+					LoanId: created.Loan.Id,
+					Amount: &v1.Money{Amount: 1, Currency: "IDR"},
+					When:   timestamppb.New(startDate.AddDate(0, 0, 2)),
+				})
+				return err
+			},
+			expectedCode:   codes.InvalidArgument,
+			expectedReason: apierror.ReasonPayoffAmountMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.call()
+			g.Expect(err).To(HaveOccurred())
+
+			st := status.Convert(err)
+			g.Expect(st.Code()).To(Equal(tc.expectedCode))
+			g.Expect(st.Details()).To(HaveLen(1))
+
+			info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(info.Reason).To(Equal(tc.expectedReason))
+			g.Expect(info.Domain).To(Equal(apierror.Domain))
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package grpchandler
 
 import (
+	"time"
+
 	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
 	v1 "github.com/bahrunnur/loan-billing-service/proto/gen/loanbilling/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func outstandingResponseFrom(loan model.WeeklyLoanFullInformation) *v1.GetOutstandingResponse {
+func outstandingResponseFrom(loan model.LoanFullInformation) *v1.GetOutstandingResponse {
 	return &v1.GetOutstandingResponse{
 		OutstandingBalance: int64(loan.OutstandingBalance.Rupiah()),
 		Decimal:            int32(loan.OutstandingBalance.Sen()),
@@ -18,3 +22,483 @@ func isDelinquentResponseFrom(isDelinquent bool) *v1.IsDelinquentResponse {
 		IsDelinquent: isDelinquent,
 	}
 }
+
+func delinquencyHistoryResponseFrom(events []model.DelinquencyEvent) *v1.GetDelinquencyHistoryResponse {
+	ret := make([]*v1.DelinquencyEvent, 0, len(events))
+	for _, e := range events {
+		ret = append(ret, &v1.DelinquencyEvent{
+			Kind:           delinquencyEventKindFrom(e.Kind),
+			At:             timestamppb.New(e.At),
+			MissedBillings: int32(e.MissedBillings),
+		})
+	}
+
+	return &v1.GetDelinquencyHistoryResponse{
+		Events: ret,
+	}
+}
+
+func termsHistoryResponseFrom(terms []model.LoanTerms) *v1.GetLoanTermsHistoryResponse {
+	ret := make([]*v1.LoanTerms, 0, len(terms))
+	for _, t := range terms {
+		ret = append(ret, &v1.LoanTerms{
+			Version:               int32(t.Version),
+			StartDate:             timestamppb.New(t.StartDate),
+			SupersededAt:          optionalTimestampFrom(t.SupersededAt),
+			Principal:             moneyFrom(t.Principal),
+			AnnualInterestRateBps: int32(t.AnnualInterestRate),
+			Frequency:             frequencyFrom(t.Frequency),
+			FirstDueDate:          optionalTimestampFrom(t.FirstDueDate),
+			LoanTerm:              int32(t.LoanTerm),
+			InterestMethod:        interestMethodFrom(t.InterestMethod),
+			RateBasis:             rateBasisFrom(t.RateBasis),
+			DayCount:              dayCountFrom(t.DayCount),
+			TotalInterest:         moneyFrom(t.TotalInterest),
+			InstallmentAmount:     moneyFrom(t.InstallmentAmount),
+		})
+	}
+
+	return &v1.GetLoanTermsHistoryResponse{
+		Terms: ret,
+	}
+}
+
+func deferInstallmentsResponseFrom(event model.DeferralEvent) *v1.DeferInstallmentsResponse {
+	return &v1.DeferInstallmentsResponse{
+		Deferral: deferralEventFrom(event),
+	}
+}
+
+func deferralHistoryResponseFrom(events []model.DeferralEvent) *v1.GetDeferralHistoryResponse {
+	ret := make([]*v1.DeferralEvent, 0, len(events))
+	for _, e := range events {
+		ret = append(ret, deferralEventFrom(e))
+	}
+
+	return &v1.GetDeferralHistoryResponse{
+		Events: ret,
+	}
+}
+
+func writeOffLoanResponseFrom(writeOff model.WriteOff) *v1.WriteOffLoanResponse {
+	return &v1.WriteOffLoanResponse{
+		WriteOff: writeOffFrom(writeOff),
+	}
+}
+
+func writeOffResponseFrom(report model.WriteOffReport) *v1.GetWriteOffResponse {
+	recoveries := make([]*v1.Payment, 0, len(report.Recoveries))
+	for _, p := range report.Recoveries {
+		recoveries = append(recoveries, paymentFrom(p))
+	}
+
+	return &v1.GetWriteOffResponse{
+		WriteOff:   writeOffFrom(report.WriteOff),
+		Recovered:  moneyFrom(report.Recovered),
+		Recoveries: recoveries,
+	}
+}
+
+func createLoanResponseFrom(loan model.InstallmentLoan) *v1.CreateLoanResponse {
+	return &v1.CreateLoanResponse{
+		Loan: loanFrom(loan),
+	}
+}
+
+func restructureLoanResponseFrom(loan model.InstallmentLoan) *v1.RestructureLoanResponse {
+	return &v1.RestructureLoanResponse{
+		Loan: loanFrom(loan),
+	}
+}
+
+func getLoanResponseFrom(loan model.LoanFullInformation) *v1.GetLoanResponse {
+	payments := make([]*v1.Payment, 0, len(loan.Payments))
+	for _, p := range loan.Payments {
+		payments = append(payments, paymentFrom(p))
+	}
+
+	return &v1.GetLoanResponse{
+		Loan: loanFrom(loan.InstallmentLoan),
+		DelinquencyStatus: &v1.DelinquencyStatus{
+			IsDelinquent:   loan.IsDelinquent,
+			LateFee:        moneyFrom(loan.LateFee),
+			DaysPastDue:    int32(loan.DaysPastDue),
+			Bucket:         bucketFrom(loan.Bucket),
+			Kolektibilitas: int32(loan.Kolektibilitas),
+			AgedAt:         timestamppb.New(loan.AgedAt),
+			DelinquentAt:   optionalTimestampFrom(loan.DelinquentAt),
+			CuredAt:        optionalTimestampFrom(loan.CuredAt),
+		},
+		Payments: payments,
+	}
+}
+
+func billingScheduleResponseFrom(billings []model.Billing) *v1.GetBillingScheduleResponse {
+	ret := make([]*v1.Billing, 0, len(billings))
+	for _, b := range billings {
+		ret = append(ret, billingFrom(b))
+	}
+
+	return &v1.GetBillingScheduleResponse{
+		Billings: ret,
+	}
+}
+
+func agingResponseFrom(aging model.Aging) *v1.GetAgingResponse {
+	return &v1.GetAgingResponse{
+		Aging: &v1.Aging{
+			LoanId:         aging.LoanID.String(),
+			AsOf:           timestamppb.New(aging.AsOf),
+			DaysPastDue:    int32(aging.DaysPastDue),
+			Bucket:         bucketFrom(aging.Bucket),
+			Kolektibilitas: int32(aging.Kolektibilitas),
+			OverdueAmount:  moneyFrom(aging.OverdueAmount),
+		},
+	}
+}
+
+func payoffQuoteResponseFrom(quote model.PayoffQuote) *v1.GetPayoffQuoteResponse {
+	return &v1.GetPayoffQuoteResponse{
+		Quote: &v1.PayoffQuote{
+			LoanId:             quote.LoanID.String(),
+			QuoteDate:          timestamppb.New(quote.QuoteDate),
+			OutstandingBalance: moneyFrom(quote.OutstandingBalance),
+			Credit:             moneyFrom(quote.Credit),
+			RemainingTerms:     int32(quote.RemainingTerms),
+			RebateRule:         rebateRuleFrom(quote.RebateRule),
+			InterestRebate:     moneyFrom(quote.InterestRebate),
+			PayoffAmount:       moneyFrom(quote.PayoffAmount),
+		},
+	}
+}
+
+func loanFrom(loan model.InstallmentLoan) *v1.Loan {
+	ret := &v1.Loan{
+		Id:                    loan.ID.String(),
+		Principal:             moneyFrom(loan.Principal),
+		AnnualInterestRateBps: int32(loan.AnnualInterestRate),
+		StartDate:             timestamppb.New(loan.StartDate),
+		TotalInterest:         moneyFrom(loan.TotalInterest),
+		OutstandingBalance:    moneyFrom(loan.OutstandingBalance),
+		IsCompleted:           loan.IsCompleted,
+		AllocationPolicy:      allocationPolicyFrom(loan.AllocationPolicy),
+		Credit:                moneyFrom(loan.Credit),
+		Frequency:             frequencyFrom(loan.Frequency),
+		LoanTerm:              int32(loan.LoanTerm),
+		InstallmentAmount:     moneyFrom(loan.InstallmentAmount),
+		InstallmentInterest:   moneyFrom(loan.InstallmentInterest),
+		InterestMethod:        interestMethodFrom(loan.InterestMethod),
+		RateBasis:             rateBasisFrom(loan.RateBasis),
+		DayCount:              dayCountFrom(loan.DayCount),
+		Product:               loan.Product,
+		DelinquencyRule: &v1.DelinquencyRule{
+			MissedPaymentThreshold: int32(loan.DelinquencyRule.MissedPaymentThreshold),
+			GraceDays:              int32(loan.DelinquencyRule.GraceDays),
+		},
+		FirstDueDate: optionalTimestampFrom(loan.FirstDueDate),
+		TermsVersion: int32(loan.TermsVersion),
+		WrittenOffAt: optionalTimestampFrom(loan.WrittenOffAt),
+	}
+
+	// keep the deprecated fields for the clients that only know weekly loans
+	if loan.Frequency == model.FrequencyWeekly {
+		ret.LoanTermWeeks = int32(loan.LoanTerm)
+		ret.WeeklyPayment = moneyFrom(loan.InstallmentAmount)
+		ret.WeeklyInterest = moneyFrom(loan.InstallmentInterest)
+	}
+
+	return ret
+}
+
+func paymentFrom(payment model.Payment) *v1.Payment {
+	return &v1.Payment{
+		Id:             payment.ID.String(),
+		Kind:           paymentKindFrom(payment.Kind),
+		Reference:      payment.Reference,
+		Date:           timestamppb.New(payment.Date),
+		Amount:         moneyFrom(payment.Amount),
+		BalanceBefore:  moneyFrom(payment.BalanceBefore),
+		BalanceAfter:   moneyFrom(payment.BalanceAfter),
+		Principal:      moneyFrom(payment.Principal),
+		Interest:       moneyFrom(payment.Interest),
+		Fee:            moneyFrom(payment.Fee),
+		Penalty:        moneyFrom(payment.Penalty),
+		Rebate:         moneyFrom(payment.Rebate),
+		ReversedAt:     optionalTimestampFrom(payment.ReversedAt),
+		ReversalReason: payment.ReversalReason,
+	}
+}
+
+func writeOffFrom(writeOff model.WriteOff) *v1.WriteOff {
+	return &v1.WriteOff{
+		At:        timestamppb.New(writeOff.At),
+		Principal: moneyFrom(writeOff.Principal),
+		Interest:  moneyFrom(writeOff.Interest),
+		Fee:       moneyFrom(writeOff.Fee),
+		Penalty:   moneyFrom(writeOff.Penalty),
+		Reason:    writeOff.Reason,
+	}
+}
+
+func deferralEventFrom(event model.DeferralEvent) *v1.DeferralEvent {
+	return &v1.DeferralEvent{
+		At:             timestamppb.New(event.At),
+		FromTerm:       int32(event.FromTerm),
+		Periods:        int32(event.Periods),
+		Method:         deferralMethodFrom(event.Method),
+		DeferredAmount: moneyFrom(event.DeferredAmount),
+		Reason:         event.Reason,
+	}
+}
+
+func billingFrom(billing model.Billing) *v1.Billing {
+	return &v1.Billing{
+		TermNumber:     int32(billing.TermNumber),
+		PaymentDueDate: timestamppb.New(billing.PaymentDueDate),
+		Repayment:      moneyFrom(billing.Repayment),
+		IsPaid:         billing.IsPaid(),
+		PaidAmount:     moneyFrom(billing.PaidAmount),
+		Principal:      moneyFrom(billing.Principal),
+		Interest:       moneyFrom(billing.Interest),
+		Fee:            moneyFrom(billing.Fee),
+		Penalty:        moneyFrom(billing.Penalty),
+		PaidPrincipal:  moneyFrom(billing.PaidPrincipal),
+		PaidInterest:   moneyFrom(billing.PaidInterest),
+		PaidFee:        moneyFrom(billing.PaidFee),
+		PaidPenalty:    moneyFrom(billing.PaidPenalty),
+		Rebate:         moneyFrom(billing.Rebate),
+		DeferredAt:     optionalTimestampFrom(billing.DeferredAt),
+	}
+}
+
+func moneyFrom(amount currency.Rupiah) *v1.Money {
+	return &v1.Money{
+		Amount:   int64(amount.Rupiah()),
+		Decimal:  int32(amount.Sen()),
+		Currency: amount.ISOCode(),
+	}
+}
+
+var allocationPolicies = map[v1.AllocationPolicy]model.AllocationPolicy{
+	v1.AllocationPolicy_ALLOCATION_POLICY_UNSPECIFIED:     "",
+	v1.AllocationPolicy_ALLOCATION_POLICY_APPLY_TO_FUTURE: model.AllocationApplyToFuture,
+	v1.AllocationPolicy_ALLOCATION_POLICY_HOLD_AS_CREDIT:  model.AllocationHoldAsCredit,
+	v1.AllocationPolicy_ALLOCATION_POLICY_EXACT_DUE:       model.AllocationExactDue,
+}
+
+func allocationPolicyFrom(policy model.AllocationPolicy) v1.AllocationPolicy {
+	for k, v := range allocationPolicies {
+		if v == policy {
+			return k
+		}
+	}
+
+	return v1.AllocationPolicy_ALLOCATION_POLICY_UNSPECIFIED
+}
+
+func allocationPolicyTo(policy v1.AllocationPolicy) model.AllocationPolicy {
+	p, ok := allocationPolicies[policy]
+	if !ok {
+		return model.AllocationPolicy(policy.String()) // rejected by the domain as unknown
+	}
+
+	return p
+}
+
+func rebateRuleFrom(rule model.RebateRule) v1.RebateRule {
+	switch rule {
+	case model.RebateNone:
+		return v1.RebateRule_REBATE_RULE_NONE
+	case model.RebateProRata:
+		return v1.RebateRule_REBATE_RULE_PRO_RATA
+	case model.RebateRuleOf78:
+		return v1.RebateRule_REBATE_RULE_RULE_OF_78
+	default:
+		return v1.RebateRule_REBATE_RULE_UNSPECIFIED
+	}
+}
+
+var frequencies = map[v1.Frequency]model.Frequency{
+	v1.Frequency_FREQUENCY_UNSPECIFIED: "",
+	v1.Frequency_FREQUENCY_WEEKLY:      model.FrequencyWeekly,
+	v1.Frequency_FREQUENCY_BIWEEKLY:    model.FrequencyBiweekly,
+	v1.Frequency_FREQUENCY_MONTHLY:     model.FrequencyMonthly,
+}
+
+func frequencyFrom(frequency model.Frequency) v1.Frequency {
+	for k, v := range frequencies {
+		if v == frequency {
+			return k
+		}
+	}
+
+	return v1.Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func frequencyTo(frequency v1.Frequency) model.Frequency {
+	f, ok := frequencies[frequency]
+	if !ok {
+		return model.Frequency(frequency.String()) // rejected by the domain as unknown
+	}
+
+	return f
+}
+
+var interestMethods = map[v1.InterestMethod]model.InterestMethod{
+	v1.InterestMethod_INTEREST_METHOD_UNSPECIFIED:       "",
+	v1.InterestMethod_INTEREST_METHOD_FLAT:              model.InterestFlat,
+	v1.InterestMethod_INTEREST_METHOD_DECLINING_BALANCE: model.InterestDecliningBalance,
+	v1.InterestMethod_INTEREST_METHOD_ANNUITY:           model.InterestAnnuity,
+}
+
+func interestMethodFrom(method model.InterestMethod) v1.InterestMethod {
+	for k, v := range interestMethods {
+		if v == method {
+			return k
+		}
+	}
+
+	return v1.InterestMethod_INTEREST_METHOD_UNSPECIFIED
+}
+
+func interestMethodTo(method v1.InterestMethod) model.InterestMethod {
+	m, ok := interestMethods[method]
+	if !ok {
+		return model.InterestMethod(method.String()) // rejected by the domain as unknown
+	}
+
+	return m
+}
+
+var rateBases = map[v1.RateBasis]model.RateBasis{
+	v1.RateBasis_RATE_BASIS_UNSPECIFIED: "",
+	v1.RateBasis_RATE_BASIS_ANNUAL:      model.RateAnnual,
+	v1.RateBasis_RATE_BASIS_PER_TENOR:   model.RatePerTenor,
+	v1.RateBasis_RATE_BASIS_MONTHLY:     model.RateMonthly,
+}
+
+func rateBasisFrom(basis model.RateBasis) v1.RateBasis {
+	for k, v := range rateBases {
+		if v == basis {
+			return k
+		}
+	}
+
+	return v1.RateBasis_RATE_BASIS_UNSPECIFIED
+}
+
+func rateBasisTo(basis v1.RateBasis) model.RateBasis {
+	b, ok := rateBases[basis]
+	if !ok {
+		return model.RateBasis(basis.String()) // rejected by the domain as unknown
+	}
+
+	return b
+}
+
+var dayCounts = map[v1.DayCount]model.DayCount{
+	v1.DayCount_DAY_COUNT_UNSPECIFIED: "",
+	v1.DayCount_DAY_COUNT_ACT_365:     model.DayCountAct365,
+	v1.DayCount_DAY_COUNT_30E_360:     model.DayCount30E360,
+}
+
+func dayCountFrom(dayCount model.DayCount) v1.DayCount {
+	for k, v := range dayCounts {
+		if v == dayCount {
+			return k
+		}
+	}
+
+	return v1.DayCount_DAY_COUNT_UNSPECIFIED
+}
+
+func dayCountTo(dayCount v1.DayCount) model.DayCount {
+	d, ok := dayCounts[dayCount]
+	if !ok {
+		return model.DayCount(dayCount.String()) // rejected by the domain as unknown
+	}
+
+	return d
+}
+
+var deferralMethods = map[v1.DeferralMethod]model.DeferralMethod{
+	v1.DeferralMethod_DEFERRAL_METHOD_UNSPECIFIED:  "",
+	v1.DeferralMethod_DEFERRAL_METHOD_EXTEND_TENOR: model.DeferralExtendTenor,
+	v1.DeferralMethod_DEFERRAL_METHOD_SPREAD:       model.DeferralSpread,
+}
+
+func deferralMethodFrom(method model.DeferralMethod) v1.DeferralMethod {
+	for k, v := range deferralMethods {
+		if v == method {
+			return k
+		}
+	}
+
+	return v1.DeferralMethod_DEFERRAL_METHOD_UNSPECIFIED
+}
+
+func deferralMethodTo(method v1.DeferralMethod) model.DeferralMethod {
+	m, ok := deferralMethods[method]
+	if !ok {
+		return model.DeferralMethod(method.String()) // rejected by the domain as unknown
+	}
+
+	return m
+}
+
+var buckets = map[v1.Bucket]model.Bucket{
+	v1.Bucket_BUCKET_CURRENT: model.BucketCurrent,
+	v1.Bucket_BUCKET_1_30:    model.Bucket1To30,
+	v1.Bucket_BUCKET_31_60:   model.Bucket31To60,
+	v1.Bucket_BUCKET_61_90:   model.Bucket61To90,
+	v1.Bucket_BUCKET_90_PLUS: model.Bucket90Plus,
+}
+
+func bucketFrom(bucket model.Bucket) v1.Bucket {
+	for k, v := range buckets {
+		if v == bucket {
+			return k
+		}
+	}
+
+	return v1.Bucket_BUCKET_UNSPECIFIED
+}
+
+var delinquencyEventKinds = map[v1.DelinquencyEventKind]model.DelinquencyEventKind{
+	v1.DelinquencyEventKind_DELINQUENCY_EVENT_KIND_DELINQUENT: model.DelinquencyEventDelinquent,
+	v1.DelinquencyEventKind_DELINQUENCY_EVENT_KIND_CURED:      model.DelinquencyEventCured,
+}
+
+func delinquencyEventKindFrom(kind model.DelinquencyEventKind) v1.DelinquencyEventKind {
+	for k, v := range delinquencyEventKinds {
+		if v == kind {
+			return k
+		}
+	}
+
+	return v1.DelinquencyEventKind_DELINQUENCY_EVENT_KIND_UNSPECIFIED
+}
+
+var paymentKinds = map[v1.PaymentKind]model.PaymentKind{
+	v1.PaymentKind_PAYMENT_KIND_REPAYMENT: model.PaymentRepayment,
+	v1.PaymentKind_PAYMENT_KIND_RECOVERY:  model.PaymentRecovery,
+}
+
+func paymentKindFrom(kind model.PaymentKind) v1.PaymentKind {
+	for k, v := range paymentKinds {
+		if v == kind {
+			return k
+		}
+	}
+
+	return v1.PaymentKind_PAYMENT_KIND_UNSPECIFIED
+}
+
+// optionalTimestampFrom leaves the timestamp unset for the zero time
+func optionalTimestampFrom(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
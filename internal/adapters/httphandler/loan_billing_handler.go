@@ -0,0 +1,602 @@
+package httphandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/apierror"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
+	"go.jetify.com/typeid"
+	"go.uber.org/zap"
+)
+
+type LoanBillingService interface {
+	CreateLoan(param model.LoanParam) (model.InstallmentLoan, error)
+	GetLoan(loanID model.LoanID) (model.LoanFullInformation, error)
+	GetBillingSchedule(loanID model.LoanID) ([]model.Billing, error)
+	CheckDelinquency(loanID model.LoanID, when time.Time) (bool, error)
+	GetDelinquencyHistory(loanID model.LoanID) ([]model.DelinquencyEvent, error)
+	MakePayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah, reference string) (model.Payment, error)
+	ReversePayment(loanID model.LoanID, paymentID model.PaymentID, when time.Time, reason string) (model.Payment, error)
+	QuotePayoff(loanID model.LoanID, at time.Time) (model.PayoffQuote, error)
+	AgeLoan(loanID model.LoanID, asOf time.Time) (model.Aging, error)
+	SettleLoan(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) (model.Payment, error)
+	RestructureLoan(loanID model.LoanID, param model.RestructureParam) (model.InstallmentLoan, error)
+	GetTermsHistory(loanID model.LoanID) ([]model.LoanTerms, error)
+	DeferInstallments(loanID model.LoanID, param model.DeferralParam) (model.DeferralEvent, error)
+	GetDeferralHistory(loanID model.LoanID) ([]model.DeferralEvent, error)
+	WriteOffLoan(loanID model.LoanID, param model.WriteOffParam) (model.WriteOff, error)
+	GetWriteOffReport(loanID model.LoanID) (model.WriteOffReport, error)
+}
+
+// LoanBillingHTTPHandler serves the REST endpoints documented in `docs/design.md`
+type LoanBillingHTTPHandler struct {
+	svc   LoanBillingService
+	clock ports.Clock
+}
+
+// Option configures the LoanBillingHTTPHandler
+type Option func(*LoanBillingHTTPHandler)
+
+// WithClock sets the time of the requests without one (default: the wall clock)
+func WithClock(clock ports.Clock) Option {
+	return func(h *LoanBillingHTTPHandler) {
+		h.clock = clock
+	}
+}
+
+func NewLoanBillingHTTPHandler(svc LoanBillingService, opts ...Option) *LoanBillingHTTPHandler {
+	h := &LoanBillingHTTPHandler{svc: svc, clock: clock.Wall{}}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// Routes registers the endpoints under `basePath`
+func (h *LoanBillingHTTPHandler) Routes(basePath string) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("POST "+basePath+"/billing/loans", h.CreateLoan)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/payments", h.MakePayment)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/payments/{payment_id}/reversal", h.ReversePayment)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/billing", h.GetBilling)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/delinquency", h.GetDelinquency)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/delinquency/history", h.GetDelinquencyHistory)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/payoff", h.GetPayoffQuote)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/settlement", h.SettleLoan)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/aging", h.GetAging)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/restructure", h.RestructureLoan)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/terms", h.GetTermsHistory)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/deferral", h.DeferInstallments)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/deferral/history", h.GetDeferralHistory)
+	mux.HandleFunc("POST "+basePath+"/billing/loans/{id}/write-off", h.WriteOffLoan)
+	mux.HandleFunc("GET "+basePath+"/billing/loans/{id}/write-off", h.GetWriteOff)
+
+	return mux
+}
+
+func (h *LoanBillingHTTPHandler) CreateLoan(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	var req createLoanRequest
+	err := decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	principal, err := req.Principal.toRupiah()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	frequency := model.Frequency(req.Frequency)
+
+	loanTerm := req.LoanTerm
+	if loanTerm == 0 && (frequency == "" || frequency == model.FrequencyWeekly) {
+		loanTerm = req.LoanTermWeeks // legacy weekly only request
+	}
+
+	loan, err := h.svc.CreateLoan(model.LoanParam{
+		Principal:          principal,
+		AnnualInterestRate: model.BPS(req.AnnualInterestRateBps),
+		Frequency:          frequency,
+		LoanTerm:           int(loanTerm),
+		AllocationPolicy:   model.AllocationPolicy(req.AllocationPolicy),
+		InterestMethod:     model.InterestMethod(req.InterestMethod),
+		RateBasis:          model.RateBasis(req.RateBasis),
+		DayCount:           model.DayCount(req.DayCount),
+		Product:            req.Product,
+		StartDate:          req.StartDate,
+		FirstDueDate:       req.FirstDueDate,
+	})
+	if err != nil {
+		logger.Error("fail to create loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, loanResponseFrom(loan))
+}
+
+func (h *LoanBillingHTTPHandler) MakePayment(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req makePaymentRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if req.When.IsZero() {
+		writeError(w, fmt.Errorf("%w: missing `when`", apierror.ErrInvalidPaymentTime))
+		return
+	}
+
+	amount, err := req.Amount.toRupiah()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	payment, err := h.svc.MakePayment(loanID, req.When, amount, req.Reference)
+	if err != nil {
+		logger.Error("fail to make payment",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paymentResponseFrom(payment))
+}
+
+func (h *LoanBillingHTTPHandler) ReversePayment(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	paymentID, err := parsePaymentID(logger, r.PathValue("payment_id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req reversePaymentRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	when := req.When
+	if when.IsZero() {
+		when = h.clock.Now().UTC()
+	}
+
+	payment, err := h.svc.ReversePayment(loanID, paymentID, when, req.Reason)
+	if err != nil {
+		logger.Error("fail to reverse payment",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paymentResponseFrom(payment))
+}
+
+func (h *LoanBillingHTTPHandler) RestructureLoan(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req restructureLoanRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	when := req.When
+	if when.IsZero() {
+		when = h.clock.Now().UTC()
+	}
+
+	loan, err := h.svc.RestructureLoan(loanID, model.RestructureParam{
+		When:               when,
+		LoanTerm:           int(req.LoanTerm),
+		AnnualInterestRate: model.BPS(req.AnnualInterestRateBps),
+		Frequency:          model.Frequency(req.Frequency),
+		FirstDueDate:       req.FirstDueDate,
+		CapitalizeArrears:  req.CapitalizeArrears,
+	})
+	if err != nil {
+		logger.Error("fail to restructure loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, loanResponseFrom(loan))
+}
+
+func (h *LoanBillingHTTPHandler) GetTermsHistory(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	terms, err := h.svc.GetTermsHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get loan terms history",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, termsHistoryResponseFrom(terms))
+}
+
+func (h *LoanBillingHTTPHandler) DeferInstallments(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req deferInstallmentsRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	when := req.When
+	if when.IsZero() {
+		when = h.clock.Now().UTC()
+	}
+
+	event, err := h.svc.DeferInstallments(loanID, model.DeferralParam{
+		When:    when,
+		Periods: int(req.Periods),
+		Method:  model.DeferralMethod(req.Method),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		logger.Error("fail to defer installments",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deferralEventResponseFrom(event))
+}
+
+func (h *LoanBillingHTTPHandler) GetDeferralHistory(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	events, err := h.svc.GetDeferralHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get deferral history",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, deferralHistoryResponseFrom(events))
+}
+
+func (h *LoanBillingHTTPHandler) WriteOffLoan(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req writeOffLoanRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	when := req.When
+	if when.IsZero() {
+		when = h.clock.Now().UTC()
+	}
+
+	writeOff, err := h.svc.WriteOffLoan(loanID, model.WriteOffParam{
+		When:   when,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		logger.Error("fail to write off loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, writeOffResponseFrom(writeOff))
+}
+
+func (h *LoanBillingHTTPHandler) GetWriteOff(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	report, err := h.svc.GetWriteOffReport(loanID)
+	if err != nil {
+		logger.Error("fail to get write-off",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, writeOffReportResponseFrom(report))
+}
+
+func (h *LoanBillingHTTPHandler) GetBilling(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	loan, err := h.svc.GetLoan(loanID)
+	if err != nil {
+		logger.Error("fail to get loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	billings, err := h.svc.GetBillingSchedule(loanID)
+	if err != nil {
+		logger.Error("fail to get billing schedule",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, billingScheduleResponseFrom(loan, billings))
+}
+
+func (h *LoanBillingHTTPHandler) GetDelinquency(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	isDelinquent, err := h.svc.CheckDelinquency(loanID, h.clock.Now().UTC())
+	if err != nil {
+		logger.Error("fail to get delinquency status",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, delinquencyResponse{IsDelinquent: isDelinquent})
+}
+
+func (h *LoanBillingHTTPHandler) GetDelinquencyHistory(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	events, err := h.svc.GetDelinquencyHistory(loanID)
+	if err != nil {
+		logger.Error("fail to get delinquency history",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, delinquencyHistoryResponseFrom(events))
+}
+
+func (h *LoanBillingHTTPHandler) GetAging(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	at := h.clock.Now().UTC()
+	if requested := r.URL.Query().Get("at"); requested != "" {
+		at, err = time.Parse(time.RFC3339, requested)
+		if err != nil {
+			writeError(w, fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
+			return
+		}
+	}
+
+	aging, err := h.svc.AgeLoan(loanID, at)
+	if err != nil {
+		logger.Error("fail to age loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, agingResponseFrom(aging))
+}
+
+func (h *LoanBillingHTTPHandler) GetPayoffQuote(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	at := h.clock.Now().UTC()
+	if requested := r.URL.Query().Get("at"); requested != "" {
+		at, err = time.Parse(time.RFC3339, requested)
+		if err != nil {
+			writeError(w, fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentTime, err))
+			return
+		}
+	}
+
+	quote, err := h.svc.QuotePayoff(loanID, at)
+	if err != nil {
+		logger.Error("fail to quote payoff",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, payoffQuoteResponseFrom(quote))
+}
+
+func (h *LoanBillingHTTPHandler) SettleLoan(w http.ResponseWriter, r *http.Request) {
+	logger := o11y.LoggerFromContext(r.Context())
+
+	loanID, err := parseLoanID(logger, r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req makePaymentRequest
+	err = decode(r, &req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if req.When.IsZero() {
+		writeError(w, fmt.Errorf("%w: missing `when`", apierror.ErrInvalidPaymentTime))
+		return
+	}
+
+	amount, err := req.Amount.toRupiah()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	payment, err := h.svc.SettleLoan(loanID, req.When, amount)
+	if err != nil {
+		logger.Error("fail to settle loan",
+			zap.Error(err),
+		)
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, paymentResponseFrom(payment))
+}
+
+func parseLoanID(logger *zap.Logger, requested string) (model.LoanID, error) {
+	loanID, err := typeid.Parse[model.LoanID](requested)
+	if err != nil {
+		logger.Error("fail to parse loan id",
+			zap.String("requested_loan_id", requested),
+		)
+		return model.LoanID{}, fmt.Errorf("%w: %w", apierror.ErrInvalidLoanID, err)
+	}
+
+	return loanID, nil
+}
+
+func parsePaymentID(logger *zap.Logger, requested string) (model.PaymentID, error) {
+	paymentID, err := typeid.Parse[model.PaymentID](requested)
+	if err != nil {
+		logger.Error("fail to parse payment id",
+			zap.String("requested_payment_id", requested),
+		)
+		return model.PaymentID{}, fmt.Errorf("%w: %w", apierror.ErrInvalidPaymentID, err)
+	}
+
+	return paymentID, nil
+}
+
+func decode(r *http.Request, v any) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return fmt.Errorf("%w: %w", apierror.ErrMalformedRequest, err)
+	}
+
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	apiErr := apierror.From(err)
+	writeJSON(w, apiErr.HTTPStatus(), errorResponseFrom(apiErr))
+}
@@ -0,0 +1,310 @@
+package httphandler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/httphandler"
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	. "github.com/onsi/gomega"
+)
+
+const basePath = "/loan-billing-service"
+
+func do(g *WithT, handler http.Handler, method, path string, body any) (int, map[string]any) {
+	var reqBody bytes.Buffer
+	if body != nil {
+		g.Expect(json.NewEncoder(&reqBody).Encode(body)).To(Succeed())
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(method, basePath+path, &reqBody))
+
+	var respBody map[string]any
+	if rec.Body.Len() > 0 {
+		g.Expect(json.NewDecoder(rec.Body).Decode(&respBody)).To(Succeed())
+	}
+
+	return rec.Code, respBody
+}
+
+func TestLoanBillingHTTPHandler(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+	handler := httphandler.NewLoanBillingHTTPHandler(loanService).Routes(basePath)
+
+	code, created := do(g, handler, http.MethodPost, "/billing/loans", map[string]any{
+		"principal":                map[string]any{"amount": 5000000, "currency": "IDR"},
+		"annual_interest_rate_bps": 1000,
+		"loan_term_weeks":          50,
+		"allocation_policy":        "exact_due",
+		"rate_basis":               "per_tenor",
+	})
+	g.Expect(code).To(Equal(http.StatusCreated))
+	g.Expect(created).To(HaveKeyWithValue("allocation_policy", "exact_due"))
+	g.Expect(created).To(HaveKeyWithValue("frequency", "weekly"))
+	g.Expect(created).To(HaveKeyWithValue("loan_term", BeNumerically("==", 50)))
+	g.Expect(created["weekly_payment"]).To(HaveKeyWithValue("amount", BeNumerically("==", 110000)))
+
+	loanID := created["id"].(string)
+	startDate, err := time.Parse(time.RFC3339, created["start_date"].(string))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	paymentRequest := map[string]any{
+		"amount":    map[string]any{"amount": 110000, "currency": "IDR"},
+		"when":      startDate.AddDate(0, 0, 2),
+		"reference": "trx-1",
+	}
+	code, payment := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/payments", loanID), paymentRequest)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(payment).To(HaveKeyWithValue("id", HavePrefix("payment_")))
+	g.Expect(payment).To(HaveKeyWithValue("reference", "trx-1"))
+	g.Expect(payment["balance_after"]).To(HaveKeyWithValue("amount", BeNumerically("==", 5500000-110000)))
+
+	// a retry of the same payment is not recorded twice
+	code, replayed := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/payments", loanID), paymentRequest)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(replayed).To(Equal(payment))
+
+	code, billing := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/billing", loanID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(billing["outstanding_balance"]).To(HaveKeyWithValue("amount", BeNumerically("==", 5500000-110000)))
+	g.Expect(billing["next_billing"]).To(HaveKeyWithValue("term_number", BeNumerically("==", 2)))
+	g.Expect(billing["billings"]).To(HaveLen(50))
+
+	code, delinquency := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/delinquency", loanID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(delinquency).To(HaveKeyWithValue("is_delinquent", false))
+
+	code, history := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/delinquency/history", loanID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(history["events"]).To(BeEmpty())
+
+	code, quote := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/payoff?at=%s", loanID, startDate.AddDate(0, 0, 2).Format(time.RFC3339)), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(quote).To(HaveKeyWithValue("rebate_rule", "pro_rata"))
+	g.Expect(quote["payoff_amount"]).To(HaveKeyWithValue("amount", BeNumerically("==", 5500000-110000-(10000*49))))
+
+	// the second installment was due on day 14
+	code, aging := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/aging?at=%s", loanID, startDate.AddDate(0, 0, 17).Format(time.RFC3339)), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(aging).To(HaveKeyWithValue("days_past_due", BeNumerically("==", 3)))
+	g.Expect(aging).To(HaveKeyWithValue("bucket", "1_30"))
+	g.Expect(aging).To(HaveKeyWithValue("kolektibilitas", BeNumerically("==", 2)))
+	g.Expect(aging["overdue_amount"]).To(HaveKeyWithValue("amount", BeNumerically("==", 110000)))
+
+	code, monthly := do(g, handler, http.MethodPost, "/billing/loans", map[string]any{
+		"principal":                map[string]any{"amount": 1200000, "currency": "IDR"},
+		"annual_interest_rate_bps": 1200,
+		"frequency":                "monthly",
+		"loan_term":                12,
+	})
+	g.Expect(code).To(Equal(http.StatusCreated))
+	g.Expect(monthly["installment_amount"]).To(HaveKeyWithValue("amount", BeNumerically("==", 112000)))
+	g.Expect(monthly).ToNot(HaveKey("weekly_payment"))
+
+	monthlyID := monthly["id"].(string)
+	monthlyStart, err := time.Parse(time.RFC3339, monthly["start_date"].(string))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	code, bounced := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/payments", monthlyID), map[string]any{
+		"amount": map[string]any{"amount": 112000, "currency": "IDR"},
+		"when":   monthlyStart.AddDate(0, 0, 1),
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(bounced).ToNot(HaveKey("reversed_at"))
+
+	reversalPath := fmt.Sprintf("/billing/loans/%s/payments/%s/reversal", monthlyID, bounced["id"])
+	code, reversed := do(g, handler, http.MethodPost, reversalPath, map[string]any{
+		"reason": "bounced",
+		"when":   monthlyStart.AddDate(0, 0, 2),
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(reversed).To(HaveKeyWithValue("id", bounced["id"]))
+	g.Expect(reversed).To(HaveKey("reversed_at"))
+	g.Expect(reversed).To(HaveKeyWithValue("reversal_reason", "bounced"))
+
+	code, billing = do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/billing", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(billing["outstanding_balance"]).To(HaveKeyWithValue("amount", BeNumerically("==", 1200000+144000)))
+
+	code, restructured := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/restructure", monthlyID), map[string]any{
+		"loan_term":                24,
+		"annual_interest_rate_bps": 1000,
+		"capitalize_arrears":       true,
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(restructured).To(HaveKeyWithValue("terms_version", BeNumerically("==", 2)))
+	g.Expect(restructured).To(HaveKeyWithValue("loan_term", BeNumerically("==", 24)))
+
+	code, billing = do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/billing", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(billing["billings"]).To(HaveLen(24))
+
+	code, terms := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/terms", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(terms["terms"]).To(HaveLen(2))
+	g.Expect(terms["terms"].([]any)[0]).To(HaveKey("superseded_at"))
+	g.Expect(terms["terms"].([]any)[1]).ToNot(HaveKey("superseded_at"))
+
+	code, deferral := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/deferral", monthlyID), map[string]any{
+		"periods": 2,
+		"reason":  "flood relief",
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(deferral).To(HaveKeyWithValue("from_term", BeNumerically("==", 1)))
+	g.Expect(deferral).To(HaveKeyWithValue("method", "extend_tenor"))
+
+	code, billing = do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/billing", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(billing["billings"]).To(HaveLen(26))
+	g.Expect(billing["billings"].([]any)[1]).To(HaveKey("deferred_at"))
+	g.Expect(billing["billings"].([]any)[2]).ToNot(HaveKey("deferred_at"))
+
+	code, deferrals := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/deferral/history", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(deferrals["events"]).To(HaveLen(1))
+
+	code, writeOff := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/write-off", monthlyID), map[string]any{
+		"reason": "deceased",
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(writeOff).To(HaveKeyWithValue("reason", "deceased"))
+
+	code, recovery := do(g, handler, http.MethodPost, fmt.Sprintf("/billing/loans/%s/payments", monthlyID), map[string]any{
+		"amount": map[string]any{"amount": 100000, "currency": "IDR"},
+		"when":   time.Now().UTC(),
+	})
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(recovery).To(HaveKeyWithValue("kind", "recovery"))
+
+	code, report := do(g, handler, http.MethodGet, fmt.Sprintf("/billing/loans/%s/write-off", monthlyID), nil)
+	g.Expect(code).To(Equal(http.StatusOK))
+	g.Expect(report["recovered"]).To(HaveKeyWithValue("amount", BeNumerically("==", 100000)))
+	g.Expect(report["recoveries"]).To(HaveLen(1))
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		body           any
+		expectedCode   int
+		expectedReason string
+	}{
+		{
+			name:           "Invalid Loan ID",
+			method:         http.MethodGet,
+			path:           "/billing/loans/not-a-loan/billing",
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "INVALID_LOAN_ID",
+		},
+		{
+			name:           "Loan Not Found",
+			method:         http.MethodGet,
+			path:           "/billing/loans/loan_01jfa7mh6xe8wb26ctphv7ca7y/delinquency",
+			expectedCode:   http.StatusNotFound,
+			expectedReason: "LOAN_NOT_FOUND",
+		},
+		{
+			name:           "Invalid Payment ID",
+			method:         http.MethodPost,
+			path:           fmt.Sprintf("/billing/loans/%s/payments/%s/reversal", loanID, loanID),
+			body:           map[string]any{"reason": "bounced"},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "INVALID_PAYMENT_ID",
+		},
+		{
+			name:           "Payment Already Reversed",
+			method:         http.MethodPost,
+			path:           reversalPath,
+			body:           map[string]any{"reason": "bounced"},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "PAYMENT_ALREADY_REVERSED",
+		},
+		{
+			name:   "Mismatch Payment",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/billing/loans/%s/payments", loanID),
+			body: map[string]any{
+				"amount": map[string]any{"amount": 1, "currency": "IDR"},
+				"when":   startDate.AddDate(0, 0, 2),
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "PAYMENT_AMOUNT_MISMATCH",
+		},
+		{
+			name:   "Reused Payment Reference",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/billing/loans/%s/payments", loanID),
+			body: map[string]any{
+				"amount":    map[string]any{"amount": 220000, "currency": "IDR"},
+				"when":      startDate.AddDate(0, 0, 2),
+				"reference": "trx-1",
+			},
+			expectedCode:   http.StatusConflict,
+			expectedReason: "IDEMPOTENCY_KEY_CONFLICT",
+		},
+		{
+			name:   "Mismatch Payoff",
+			method: http.MethodPost,
+			path:   fmt.Sprintf("/billing/loans/%s/settlement", loanID),
+			body: map[string]any{
+				"amount": map[string]any{"amount": 1, "currency": "IDR"},
+				"when":   startDate.AddDate(0, 0, 2),
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "PAYOFF_AMOUNT_MISMATCH",
+		},
+		{
+			name:   "Unknown Frequency",
+			method: http.MethodPost,
+			path:   "/billing/loans",
+			body: map[string]any{
+				"principal":                map[string]any{"amount": 1200000, "currency": "IDR"},
+				"annual_interest_rate_bps": 1200,
+				"frequency":                "daily",
+				"loan_term":                12,
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "UNKNOWN_FREQUENCY",
+		},
+		{
+			name:   "Start Date Out of Window",
+			method: http.MethodPost,
+			path:   "/billing/loans",
+			body: map[string]any{
+				"principal":                map[string]any{"amount": 1200000, "currency": "IDR"},
+				"annual_interest_rate_bps": 1200,
+				"frequency":                "monthly",
+				"loan_term":                12,
+				"start_date":               startDate.AddDate(0, -1, 0),
+			},
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "START_DATE_OUT_OF_WINDOW",
+		},
+		{
+			name:           "Malformed Request",
+			method:         http.MethodPost,
+			path:           "/billing/loans",
+			body:           "principal",
+			expectedCode:   http.StatusBadRequest,
+			expectedReason: "MALFORMED_REQUEST",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, body := do(g, handler, tc.method, tc.path, tc.body)
+			g.Expect(code).To(Equal(tc.expectedCode))
+			g.Expect(body["error"]).To(HaveKeyWithValue("reason", tc.expectedReason))
+		})
+	}
+}
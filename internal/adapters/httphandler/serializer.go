@@ -0,0 +1,500 @@
+package httphandler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/apierror"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"google.golang.org/genproto/googleapis/rpc/code"
+)
+
+// money mirrors the gRPC `Money` message
+type money struct {
+	Amount   int64  `json:"amount"`
+	Decimal  int32  `json:"decimal"`
+	Currency string `json:"currency"`
+}
+
+func (m money) toRupiah() (currency.Rupiah, error) {
+	amount := currency.NewRupiah(int(m.Amount), int(m.Decimal))
+	if m.Currency != amount.ISOCode() {
+		return 0, fmt.Errorf("%w: %q", apierror.ErrUnsupportedCurrency, m.Currency)
+	}
+
+	return amount, nil
+}
+
+type createLoanRequest struct {
+	Principal             money     `json:"principal"`
+	AnnualInterestRateBps int32     `json:"annual_interest_rate_bps"`
+	LoanTermWeeks         int32     `json:"loan_term_weeks"` // deprecated, used as `loan_term` of a weekly loan
+	AllocationPolicy      string    `json:"allocation_policy"`
+	Frequency             string    `json:"frequency"`
+	LoanTerm              int32     `json:"loan_term"`
+	InterestMethod        string    `json:"interest_method"`
+	RateBasis             string    `json:"rate_basis"`
+	DayCount              string    `json:"day_count"`
+	Product               string    `json:"product"`
+	StartDate             time.Time `json:"start_date"`     // optional, default to now
+	FirstDueDate          time.Time `json:"first_due_date"` // optional, default to one installment after the start
+}
+
+type makePaymentRequest struct {
+	Amount    money     `json:"amount"`
+	When      time.Time `json:"when"`
+	Reference string    `json:"reference"` // optional idempotency key, a retry returns the payment recorded the first time
+}
+
+type reversePaymentRequest struct {
+	Reason string    `json:"reason"`
+	When   time.Time `json:"when"` // optional, default to now
+}
+
+type restructureLoanRequest struct {
+	LoanTerm              int32     `json:"loan_term"`
+	AnnualInterestRateBps int32     `json:"annual_interest_rate_bps"`
+	Frequency             string    `json:"frequency"`      // optional, default to the frequency of the loan
+	FirstDueDate          time.Time `json:"first_due_date"` // optional, default to one installment after `when`
+	CapitalizeArrears     bool      `json:"capitalize_arrears"`
+	When                  time.Time `json:"when"` // optional, default to now
+}
+
+type deferInstallmentsRequest struct {
+	Periods int32     `json:"periods"`
+	Method  string    `json:"method"` // optional, default to extend the tenor
+	Reason  string    `json:"reason"`
+	When    time.Time `json:"when"` // optional, default to now
+}
+
+type writeOffLoanRequest struct {
+	Reason string    `json:"reason"`
+	When   time.Time `json:"when"` // optional, default to now
+}
+
+type paymentResponse struct {
+	ID            string    `json:"id"`
+	Kind          string    `json:"kind"`
+	Reference     string    `json:"reference,omitempty"`
+	Date          time.Time `json:"date"`
+	Amount        money     `json:"amount"`
+	BalanceBefore money     `json:"balance_before"`
+	BalanceAfter  money     `json:"balance_after"`
+	Principal     money     `json:"principal"`
+	Interest      money     `json:"interest"`
+	Fee           money     `json:"fee"`
+	Penalty       money     `json:"penalty"`
+	Rebate        money     `json:"rebate"` // the unearned interest given back by a settlement, not paid
+
+	ReversedAt     *time.Time `json:"reversed_at,omitempty"` // unset unless reversed
+	ReversalReason string     `json:"reversal_reason,omitempty"`
+}
+
+type loanResponse struct {
+	ID                    string          `json:"id"`
+	Principal             money           `json:"principal"`
+	AnnualInterestRateBps int32           `json:"annual_interest_rate_bps"`
+	StartDate             time.Time       `json:"start_date"`
+	TotalInterest         money           `json:"total_interest"`
+	OutstandingBalance    money           `json:"outstanding_balance"`
+	IsCompleted           bool            `json:"is_completed"`
+	AllocationPolicy      string          `json:"allocation_policy"`
+	Credit                money           `json:"credit"`
+	Frequency             string          `json:"frequency"`
+	LoanTerm              int32           `json:"loan_term"`
+	InstallmentAmount     money           `json:"installment_amount"`
+	InstallmentInterest   money           `json:"installment_interest"`
+	InterestMethod        string          `json:"interest_method"`
+	RateBasis             string          `json:"rate_basis"`
+	DayCount              string          `json:"day_count"`
+	Product               string          `json:"product"`
+	DelinquencyRule       delinquencyRule `json:"delinquency_rule"`
+	FirstDueDate          *time.Time      `json:"first_due_date,omitempty"` // unset when one installment after the start
+	TermsVersion          int32           `json:"terms_version"`
+	WrittenOffAt          *time.Time      `json:"written_off_at,omitempty"` // unset unless written off
+
+	// deprecated, only set for weekly loans
+	LoanTermWeeks  int32  `json:"loan_term_weeks,omitempty"`
+	WeeklyPayment  *money `json:"weekly_payment,omitempty"`
+	WeeklyInterest *money `json:"weekly_interest,omitempty"`
+}
+
+type loanTermsResponse struct {
+	Version               int32      `json:"version"`
+	StartDate             time.Time  `json:"start_date"`
+	SupersededAt          *time.Time `json:"superseded_at,omitempty"` // unset for the current version
+	Principal             money      `json:"principal"`
+	AnnualInterestRateBps int32      `json:"annual_interest_rate_bps"`
+	Frequency             string     `json:"frequency"`
+	FirstDueDate          *time.Time `json:"first_due_date,omitempty"`
+	LoanTerm              int32      `json:"loan_term"`
+	InterestMethod        string     `json:"interest_method"`
+	RateBasis             string     `json:"rate_basis"`
+	DayCount              string     `json:"day_count"`
+	TotalInterest         money      `json:"total_interest"`
+	InstallmentAmount     money      `json:"installment_amount"`
+}
+
+type termsHistoryResponse struct {
+	Terms []loanTermsResponse `json:"terms"`
+}
+
+type delinquencyRule struct {
+	MissedPaymentThreshold int32 `json:"missed_payment_threshold"`
+	GraceDays              int32 `json:"grace_days"`
+}
+
+type billingResponse struct {
+	TermNumber     int32     `json:"term_number"`
+	PaymentDueDate time.Time `json:"payment_due_date"`
+	Repayment      money     `json:"repayment"`
+	IsPaid         bool      `json:"is_paid"`
+	PaidAmount     money     `json:"paid_amount"`
+	Principal      money     `json:"principal"`
+	Interest       money     `json:"interest"`
+	Fee            money     `json:"fee"`
+	Penalty        money     `json:"penalty"`
+	PaidPrincipal  money     `json:"paid_principal"`
+	PaidInterest   money     `json:"paid_interest"`
+	PaidFee        money     `json:"paid_fee"`
+	PaidPenalty    money     `json:"paid_penalty"`
+	Rebate         money     `json:"rebate"` // the unearned interest given back by a settlement, counted as paid
+
+	DeferredAt *time.Time `json:"deferred_at,omitempty"` // unset unless deferred by a payment holiday
+}
+
+type billingScheduleResponse struct {
+	OutstandingBalance money             `json:"outstanding_balance"`
+	NextBilling        *billingResponse  `json:"next_billing"`
+	Billings           []billingResponse `json:"billings"`
+}
+
+type payoffQuoteResponse struct {
+	LoanID             string    `json:"loan_id"`
+	QuoteDate          time.Time `json:"quote_date"`
+	OutstandingBalance money     `json:"outstanding_balance"`
+	Credit             money     `json:"credit"`
+	RemainingTerms     int32     `json:"remaining_terms"`
+	RebateRule         string    `json:"rebate_rule"`
+	InterestRebate     money     `json:"interest_rebate"`
+	PayoffAmount       money     `json:"payoff_amount"`
+}
+
+type agingResponse struct {
+	LoanID         string    `json:"loan_id"`
+	AsOf           time.Time `json:"as_of"`
+	DaysPastDue    int32     `json:"days_past_due"`
+	Bucket         string    `json:"bucket"`
+	Kolektibilitas int32     `json:"kolektibilitas"`
+	OverdueAmount  money     `json:"overdue_amount"`
+}
+
+type delinquencyResponse struct {
+	IsDelinquent bool `json:"is_delinquent"`
+}
+
+type delinquencyEventResponse struct {
+	Kind           string    `json:"kind"`
+	At             time.Time `json:"at"`
+	MissedBillings int32     `json:"missed_billings"`
+}
+
+type delinquencyHistoryResponse struct {
+	Events []delinquencyEventResponse `json:"events"`
+}
+
+type deferralEventResponse struct {
+	At             time.Time `json:"at"`
+	FromTerm       int32     `json:"from_term"`
+	Periods        int32     `json:"periods"`
+	Method         string    `json:"method"`
+	DeferredAmount money     `json:"deferred_amount"`
+	Reason         string    `json:"reason"`
+}
+
+type deferralHistoryResponse struct {
+	Events []deferralEventResponse `json:"events"`
+}
+
+type writeOffResponse struct {
+	At        time.Time `json:"at"`
+	Principal money     `json:"principal"`
+	Interest  money     `json:"interest"`
+	Fee       money     `json:"fee"`
+	Penalty   money     `json:"penalty"`
+	Reason    string    `json:"reason"`
+}
+
+type writeOffReportResponse struct {
+	WriteOff   writeOffResponse  `json:"write_off"`
+	Recovered  money             `json:"recovered"`
+	Recoveries []paymentResponse `json:"recoveries"`
+}
+
+// errorResponse follows the JSON mapping of google.rpc.Status so both transports speak the same errors
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Code    int    `json:"code"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+	Domain  string `json:"domain"`
+}
+
+func moneyFrom(amount currency.Rupiah) money {
+	return money{
+		Amount:   int64(amount.Rupiah()),
+		Decimal:  int32(amount.Sen()),
+		Currency: amount.ISOCode(),
+	}
+}
+
+func loanResponseFrom(loan model.InstallmentLoan) loanResponse {
+	ret := loanResponse{
+		ID:                    loan.ID.String(),
+		Principal:             moneyFrom(loan.Principal),
+		AnnualInterestRateBps: int32(loan.AnnualInterestRate),
+		StartDate:             loan.StartDate,
+		TotalInterest:         moneyFrom(loan.TotalInterest),
+		OutstandingBalance:    moneyFrom(loan.OutstandingBalance),
+		IsCompleted:           loan.IsCompleted,
+		AllocationPolicy:      string(loan.AllocationPolicy),
+		Credit:                moneyFrom(loan.Credit),
+		Frequency:             string(loan.Frequency),
+		LoanTerm:              int32(loan.LoanTerm),
+		InstallmentAmount:     moneyFrom(loan.InstallmentAmount),
+		InstallmentInterest:   moneyFrom(loan.InstallmentInterest),
+		InterestMethod:        string(loan.InterestMethod),
+		RateBasis:             string(loan.RateBasis),
+		DayCount:              string(loan.DayCount),
+		Product:               loan.Product,
+		DelinquencyRule: delinquencyRule{
+			MissedPaymentThreshold: int32(loan.DelinquencyRule.MissedPaymentThreshold),
+			GraceDays:              int32(loan.DelinquencyRule.GraceDays),
+		},
+		TermsVersion: int32(loan.TermsVersion),
+	}
+
+	if !loan.FirstDueDate.IsZero() {
+		ret.FirstDueDate = &loan.FirstDueDate
+	}
+
+	if loan.IsWrittenOff() {
+		ret.WrittenOffAt = &loan.WrittenOffAt
+	}
+
+	if loan.Frequency == model.FrequencyWeekly {
+		weeklyPayment := moneyFrom(loan.InstallmentAmount)
+		weeklyInterest := moneyFrom(loan.InstallmentInterest)
+
+		ret.LoanTermWeeks = int32(loan.LoanTerm)
+		ret.WeeklyPayment = &weeklyPayment
+		ret.WeeklyInterest = &weeklyInterest
+	}
+
+	return ret
+}
+
+func paymentResponseFrom(payment model.Payment) paymentResponse {
+	ret := paymentResponse{
+		ID:            payment.ID.String(),
+		Kind:          string(payment.Kind),
+		Reference:     payment.Reference,
+		Date:          payment.Date,
+		Amount:        moneyFrom(payment.Amount),
+		BalanceBefore: moneyFrom(payment.BalanceBefore),
+		BalanceAfter:  moneyFrom(payment.BalanceAfter),
+		Principal:     moneyFrom(payment.Principal),
+		Interest:      moneyFrom(payment.Interest),
+		Fee:           moneyFrom(payment.Fee),
+		Penalty:       moneyFrom(payment.Penalty),
+		Rebate:        moneyFrom(payment.Rebate),
+	}
+
+	if payment.IsReversed() {
+		ret.ReversedAt = &payment.ReversedAt
+		ret.ReversalReason = payment.ReversalReason
+	}
+
+	return ret
+}
+
+func billingResponseFrom(billing model.Billing) billingResponse {
+	ret := billingResponse{
+		TermNumber:     int32(billing.TermNumber),
+		PaymentDueDate: billing.PaymentDueDate,
+		Repayment:      moneyFrom(billing.Repayment),
+		IsPaid:         billing.IsPaid(),
+		PaidAmount:     moneyFrom(billing.PaidAmount),
+		Principal:      moneyFrom(billing.Principal),
+		Interest:       moneyFrom(billing.Interest),
+		Fee:            moneyFrom(billing.Fee),
+		Penalty:        moneyFrom(billing.Penalty),
+		PaidPrincipal:  moneyFrom(billing.PaidPrincipal),
+		PaidInterest:   moneyFrom(billing.PaidInterest),
+		PaidFee:        moneyFrom(billing.PaidFee),
+		PaidPenalty:    moneyFrom(billing.PaidPenalty),
+		Rebate:         moneyFrom(billing.Rebate),
+	}
+
+	if !billing.DeferredAt.IsZero() {
+		ret.DeferredAt = &billing.DeferredAt
+	}
+
+	return ret
+}
+
+func billingScheduleResponseFrom(loan model.LoanFullInformation, billings []model.Billing) billingScheduleResponse {
+	ret := billingScheduleResponse{
+		OutstandingBalance: moneyFrom(loan.OutstandingBalance),
+		Billings:           make([]billingResponse, 0, len(billings)),
+	}
+
+	for _, b := range billings {
+		br := billingResponseFrom(b)
+		if ret.NextBilling == nil && !b.IsPaid() {
+			ret.NextBilling = &br
+		}
+		ret.Billings = append(ret.Billings, br)
+	}
+
+	return ret
+}
+
+func payoffQuoteResponseFrom(quote model.PayoffQuote) payoffQuoteResponse {
+	return payoffQuoteResponse{
+		LoanID:             quote.LoanID.String(),
+		QuoteDate:          quote.QuoteDate,
+		OutstandingBalance: moneyFrom(quote.OutstandingBalance),
+		Credit:             moneyFrom(quote.Credit),
+		RemainingTerms:     int32(quote.RemainingTerms),
+		RebateRule:         string(quote.RebateRule),
+		InterestRebate:     moneyFrom(quote.InterestRebate),
+		PayoffAmount:       moneyFrom(quote.PayoffAmount),
+	}
+}
+
+func agingResponseFrom(aging model.Aging) agingResponse {
+	return agingResponse{
+		LoanID:         aging.LoanID.String(),
+		AsOf:           aging.AsOf,
+		DaysPastDue:    int32(aging.DaysPastDue),
+		Bucket:         string(aging.Bucket),
+		Kolektibilitas: int32(aging.Kolektibilitas),
+		OverdueAmount:  moneyFrom(aging.OverdueAmount),
+	}
+}
+
+func delinquencyHistoryResponseFrom(events []model.DelinquencyEvent) delinquencyHistoryResponse {
+	ret := delinquencyHistoryResponse{
+		Events: make([]delinquencyEventResponse, 0, len(events)),
+	}
+
+	for _, e := range events {
+		ret.Events = append(ret.Events, delinquencyEventResponse{
+			Kind:           string(e.Kind),
+			At:             e.At,
+			MissedBillings: int32(e.MissedBillings),
+		})
+	}
+
+	return ret
+}
+
+func termsHistoryResponseFrom(terms []model.LoanTerms) termsHistoryResponse {
+	ret := termsHistoryResponse{
+		Terms: make([]loanTermsResponse, 0, len(terms)),
+	}
+
+	for _, t := range terms {
+		tr := loanTermsResponse{
+			Version:               int32(t.Version),
+			StartDate:             t.StartDate,
+			Principal:             moneyFrom(t.Principal),
+			AnnualInterestRateBps: int32(t.AnnualInterestRate),
+			Frequency:             string(t.Frequency),
+			LoanTerm:              int32(t.LoanTerm),
+			InterestMethod:        string(t.InterestMethod),
+			RateBasis:             string(t.RateBasis),
+			DayCount:              string(t.DayCount),
+			TotalInterest:         moneyFrom(t.TotalInterest),
+			InstallmentAmount:     moneyFrom(t.InstallmentAmount),
+		}
+
+		if !t.SupersededAt.IsZero() {
+			tr.SupersededAt = &t.SupersededAt
+		}
+
+		if !t.FirstDueDate.IsZero() {
+			tr.FirstDueDate = &t.FirstDueDate
+		}
+
+		ret.Terms = append(ret.Terms, tr)
+	}
+
+	return ret
+}
+
+func deferralEventResponseFrom(event model.DeferralEvent) deferralEventResponse {
+	return deferralEventResponse{
+		At:             event.At,
+		FromTerm:       int32(event.FromTerm),
+		Periods:        int32(event.Periods),
+		Method:         string(event.Method),
+		DeferredAmount: moneyFrom(event.DeferredAmount),
+		Reason:         event.Reason,
+	}
+}
+
+func deferralHistoryResponseFrom(events []model.DeferralEvent) deferralHistoryResponse {
+	ret := deferralHistoryResponse{
+		Events: make([]deferralEventResponse, 0, len(events)),
+	}
+
+	for _, e := range events {
+		ret.Events = append(ret.Events, deferralEventResponseFrom(e))
+	}
+
+	return ret
+}
+
+func writeOffResponseFrom(writeOff model.WriteOff) writeOffResponse {
+	return writeOffResponse{
+		At:        writeOff.At,
+		Principal: moneyFrom(writeOff.Principal),
+		Interest:  moneyFrom(writeOff.Interest),
+		Fee:       moneyFrom(writeOff.Fee),
+		Penalty:   moneyFrom(writeOff.Penalty),
+		Reason:    writeOff.Reason,
+	}
+}
+
+func writeOffReportResponseFrom(report model.WriteOffReport) writeOffReportResponse {
+	ret := writeOffReportResponse{
+		WriteOff:   writeOffResponseFrom(report.WriteOff),
+		Recovered:  moneyFrom(report.Recovered),
+		Recoveries: make([]paymentResponse, 0, len(report.Recoveries)),
+	}
+
+	for _, p := range report.Recoveries {
+		ret.Recoveries = append(ret.Recoveries, paymentResponseFrom(p))
+	}
+
+	return ret
+}
+
+func errorResponseFrom(apiErr apierror.Error) errorResponse {
+	return errorResponse{
+		Error: errorBody{
+			Code:    apiErr.HTTPStatus(),
+			Status:  code.Code(apiErr.Code).String(),
+			Message: apiErr.Message,
+			Reason:  apiErr.Reason,
+			Domain:  apierror.Domain,
+		},
+	}
+}
@@ -1,31 +1,112 @@
 package memorystorage
 
 import (
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
 )
 
 type LoanStorage struct {
+	// `txMu` serializes units of work, there is only one writer transaction at a time
+	txMu sync.Mutex
 	// `mu` makes `MemoryStorage` to be thread-safe for parallel test
 	mu                sync.RWMutex
-	loans             map[model.LoanID]model.WeeklyLoan
-	payments          map[model.LoanID][]model.Payment         // 1..n
-	billings          map[model.LoanID][]model.Billing         // 1..n
-	delinquencyStatus map[model.LoanID]model.DelinquencyStatus // 1..1
+	loans             map[model.LoanID]model.InstallmentLoan
+	payments          map[model.LoanID][]model.Payment          // 1..n
+	billings          map[model.LoanID][]model.Billing          // 1..n
+	delinquencyStatus map[model.LoanID]model.DelinquencyStatus  // 1..1
+	delinquencyEvents map[model.LoanID][]model.DelinquencyEvent // 0..n
+	loanTerms         map[model.LoanID][]model.LoanTerms        // 0..n, the superseded versions
+	deferralEvents    map[model.LoanID][]model.DeferralEvent    // 0..n
+	writeOffs         map[model.LoanID]model.WriteOff           // 0..1
+	endOfDayRuns      map[string]model.EndOfDayRun              // by run key
 }
 
 func NewLoanMemoryStorage() *LoanStorage {
 	return &LoanStorage{
-		loans:             map[model.LoanID]model.WeeklyLoan{},
+		loans:             map[model.LoanID]model.InstallmentLoan{},
 		payments:          map[model.LoanID][]model.Payment{},
 		billings:          map[model.LoanID][]model.Billing{},
 		delinquencyStatus: map[model.LoanID]model.DelinquencyStatus{},
+		delinquencyEvents: map[model.LoanID][]model.DelinquencyEvent{},
+		loanTerms:         map[model.LoanID][]model.LoanTerms{},
+		deferralEvents:    map[model.LoanID][]model.DeferralEvent{},
+		writeOffs:         map[model.LoanID]model.WriteOff{},
+		endOfDayRuns:      map[string]model.EndOfDayRun{},
 	}
 }
 
-func (ms *LoanStorage) CreateLoan(loan model.WeeklyLoan) error {
+// WithinTx emulates SQL transaction: `fn` works on a snapshot of the storage that replaces the current state on
+// commit, and simply discarded on rollback
+func (ms *LoanStorage) WithinTx(fn func(tx ports.LoanStorage) error) error {
+	ms.txMu.Lock()
+	defer ms.txMu.Unlock()
+
+	tx := ms.snapshot()
+
+	err := fn(tx)
+	if err != nil {
+		return err
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.loans = tx.loans
+	ms.payments = tx.payments
+	ms.billings = tx.billings
+	ms.delinquencyStatus = tx.delinquencyStatus
+	ms.delinquencyEvents = tx.delinquencyEvents
+	ms.loanTerms = tx.loanTerms
+	ms.deferralEvents = tx.deferralEvents
+	ms.writeOffs = tx.writeOffs
+	ms.endOfDayRuns = tx.endOfDayRuns
+
+	return nil
+}
+
+func (ms *LoanStorage) snapshot() *LoanStorage {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	tx := &LoanStorage{
+		loans:             maps.Clone(ms.loans),
+		payments:          maps.Clone(ms.payments),
+		billings:          maps.Clone(ms.billings),
+		delinquencyStatus: maps.Clone(ms.delinquencyStatus),
+		delinquencyEvents: maps.Clone(ms.delinquencyEvents),
+		loanTerms:         maps.Clone(ms.loanTerms),
+		deferralEvents:    maps.Clone(ms.deferralEvents),
+		writeOffs:         maps.Clone(ms.writeOffs),
+		endOfDayRuns:      maps.Clone(ms.endOfDayRuns),
+	}
+
+	// slices are shared by `maps.Clone`, copy them so the tx doesn't write through the committed state
+	for loanID, payments := range tx.payments {
+		tx.payments[loanID] = slices.Clone(payments)
+	}
+	for loanID, billings := range tx.billings {
+		tx.billings[loanID] = slices.Clone(billings)
+	}
+	for loanID, events := range tx.delinquencyEvents {
+		tx.delinquencyEvents[loanID] = slices.Clone(events)
+	}
+	for loanID, terms := range tx.loanTerms {
+		tx.loanTerms[loanID] = slices.Clone(terms)
+	}
+	for loanID, events := range tx.deferralEvents {
+		tx.deferralEvents[loanID] = slices.Clone(events)
+	}
+
+	return tx
+}
+
+func (ms *LoanStorage) CreateLoan(loan model.InstallmentLoan) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
@@ -34,60 +115,60 @@ func (ms *LoanStorage) CreateLoan(loan model.WeeklyLoan) error {
 	return nil
 }
 
-func (ms *LoanStorage) GetLoan(loanID model.LoanID) (model.WeeklyLoan, error) {
+func (ms *LoanStorage) GetLoan(loanID model.LoanID) (model.InstallmentLoan, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
 	loan, ok := ms.loans[loanID]
 	if !ok {
-		return model.WeeklyLoan{}, model.ErrLoanNotFound
+		return model.InstallmentLoan{}, model.ErrLoanNotFound
 	}
 
 	return loan, nil
 }
 
-func (ms *LoanStorage) GetLoanWithDelinquency(loanID model.LoanID) (model.WeeklyLoanWithDelinquency, error) {
+func (ms *LoanStorage) GetLoanWithDelinquency(loanID model.LoanID) (model.LoanWithDelinquency, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
 	loan, ok := ms.loans[loanID]
 	if !ok {
-		return model.WeeklyLoanWithDelinquency{}, model.ErrLoanNotFound
+		return model.LoanWithDelinquency{}, model.ErrLoanNotFound
 	}
 
 	delinquency, ok := ms.delinquencyStatus[loanID]
 	if !ok {
-		return model.WeeklyLoanWithDelinquency{}, model.ErrDelinquencyStatusNotFound
+		return model.LoanWithDelinquency{}, model.ErrDelinquencyStatusNotFound
 	}
 
 	// emulate SQL JOIN
-	ret := model.WeeklyLoanWithDelinquency{
-		WeeklyLoan:        loan,
+	ret := model.LoanWithDelinquency{
+		InstallmentLoan:   loan,
 		DelinquencyStatus: delinquency,
 	}
 
 	return ret, nil
 }
 
-func (ms *LoanStorage) GetLoanFullInformation(loanID model.LoanID) (model.WeeklyLoanFullInformation, error) {
+func (ms *LoanStorage) GetLoanFullInformation(loanID model.LoanID) (model.LoanFullInformation, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
 	loan, ok := ms.loans[loanID]
 	if !ok {
-		return model.WeeklyLoanFullInformation{}, model.ErrLoanNotFound
+		return model.LoanFullInformation{}, model.ErrLoanNotFound
 	}
 
 	delinquency, ok := ms.delinquencyStatus[loanID]
 	if !ok {
-		return model.WeeklyLoanFullInformation{}, model.ErrDelinquencyStatusNotFound
+		return model.LoanFullInformation{}, model.ErrDelinquencyStatusNotFound
 	}
 
 	payments := ms.payments[loanID]
 
 	// emulate SQL JOIN
-	ret := model.WeeklyLoanFullInformation{
-		WeeklyLoan:        loan,
+	ret := model.LoanFullInformation{
+		InstallmentLoan:   loan,
 		DelinquencyStatus: delinquency,
 		Payments:          payments,
 	}
@@ -95,7 +176,27 @@ func (ms *LoanStorage) GetLoanFullInformation(loanID model.LoanID) (model.Weekly
 	return ret, nil
 }
 
-func (ms *LoanStorage) UpdateLoan(loanID model.LoanID, updateParams model.WeeklyLoan) error {
+func (ms *LoanStorage) ListActiveLoans(after model.LoanID, limit int) ([]model.LoanID, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	// emulate SQL WHERE NOT is_completed AND written_off_at IS NULL AND id > ? ORDER BY id LIMIT ?, the typeid suffix
+	// sorts like the uuid
+	ret := []model.LoanID{}
+	for loanID, loan := range ms.loans {
+		if !loan.IsCompleted && !loan.IsWrittenOff() && loanID.String() > after.String() {
+			ret = append(ret, loanID)
+		}
+	}
+
+	slices.SortFunc(ret, func(a, b model.LoanID) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return ret[:min(limit, len(ret))], nil
+}
+
+func (ms *LoanStorage) UpdateLoan(loanID model.LoanID, updateParams model.InstallmentLoan) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
@@ -104,20 +205,29 @@ func (ms *LoanStorage) UpdateLoan(loanID model.LoanID, updateParams model.Weekly
 	return nil
 }
 
-func (ms *LoanStorage) UpdateLoanDelinquency(loanID model.LoanID, delinquency bool) error {
+func (ms *LoanStorage) RecordLoanTerms(loanID model.LoanID, terms model.LoanTerms) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	d, ok := ms.delinquencyStatus[loanID]
-	if !ok {
-		return model.ErrLoanNotFound
+	ms.loanTerms[loanID] = append(ms.loanTerms[loanID], terms)
+
+	return nil
+}
+
+func (ms *LoanStorage) GetLoanTerms(loanID model.LoanID) ([]model.LoanTerms, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.loans[loanID]; !ok {
+		return nil, model.ErrLoanNotFound
 	}
 
-	// emulate SQL UPDATE on 1 column
-	d.IsDelinquent = delinquency
-	ms.delinquencyStatus[loanID] = d
+	terms := slices.Clone(ms.loanTerms[loanID])
+	slices.SortFunc(terms, func(a, b model.LoanTerms) int {
+		return a.Version - b.Version
+	})
 
-	return nil
+	return terms, nil
 }
 
 func (ms *LoanStorage) CreateDelinquencyStatus(loanID model.LoanID, delinquencyStatus model.DelinquencyStatus) error {
@@ -150,40 +260,159 @@ func (ms *LoanStorage) UpdateDelinquencyStatus(loanID model.LoanID, updateParams
 	return nil
 }
 
+func (ms *LoanStorage) RecordDelinquencyEvent(loanID model.LoanID, event model.DelinquencyEvent) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.delinquencyEvents[loanID] = append(ms.delinquencyEvents[loanID], event)
+
+	return nil
+}
+
+func (ms *LoanStorage) GetDelinquencyEvents(loanID model.LoanID) ([]model.DelinquencyEvent, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.loans[loanID]; !ok {
+		return nil, model.ErrLoanNotFound
+	}
+
+	events := slices.Clone(ms.delinquencyEvents[loanID])
+	slices.SortStableFunc(events, func(a, b model.DelinquencyEvent) int {
+		return a.At.Compare(b.At)
+	})
+
+	return events, nil
+}
+
+func (ms *LoanStorage) RecordDeferralEvent(loanID model.LoanID, event model.DeferralEvent) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.deferralEvents[loanID] = append(ms.deferralEvents[loanID], event)
+
+	return nil
+}
+
+func (ms *LoanStorage) GetDeferralEvents(loanID model.LoanID) ([]model.DeferralEvent, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.loans[loanID]; !ok {
+		return nil, model.ErrLoanNotFound
+	}
+
+	events := slices.Clone(ms.deferralEvents[loanID])
+	slices.SortStableFunc(events, func(a, b model.DeferralEvent) int {
+		return a.At.Compare(b.At)
+	})
+
+	return events, nil
+}
+
+func (ms *LoanStorage) CreateWriteOff(loanID model.LoanID, writeOff model.WriteOff) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.writeOffs[loanID] = writeOff
+
+	return nil
+}
+
+func (ms *LoanStorage) GetWriteOff(loanID model.LoanID) (model.WriteOff, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.loans[loanID]; !ok {
+		return model.WriteOff{}, model.ErrLoanNotFound
+	}
+
+	writeOff, ok := ms.writeOffs[loanID]
+	if !ok {
+		return model.WriteOff{}, model.ErrWriteOffNotFound
+	}
+
+	return writeOff, nil
+}
+
 func (ms *LoanStorage) RecordPayment(loanID model.LoanID, payment model.Payment) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
+	// emulate the SQL UNIQUE (loan_id, reference)
+	if payment.Reference != "" {
+		for _, p := range ms.payments[loanID] {
+			if p.Reference == payment.Reference {
+				return model.ErrIdempotencyKeyConflict
+			}
+		}
+	}
+
 	ms.payments[loanID] = append(ms.payments[loanID], payment)
 
 	return nil
 }
 
-func (ms *LoanStorage) CreateBilling(loanID model.LoanID, param model.BillingParam) error {
+func (ms *LoanStorage) GetPayment(loanID model.LoanID, paymentID model.PaymentID) (model.Payment, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	currentDate := param.LoanCreationDate.UTC().AddDate(0, 0, 7)
-	// emulate SQL COPY with Transaction block
-	for i := range param.NumberOfTerm {
-		b := model.Billing{
-			LoanID:         loanID,
-			TermNumber:     i + 1,
-			Repayment:      param.RepaymentAmount,
-			PaymentDueDate: currentDate,
-			IsPaid:         false,
+	for _, p := range ms.payments[loanID] {
+		if p.ID == paymentID {
+			return p, nil
+		}
+	}
+
+	return model.Payment{}, model.ErrPaymentNotFound
+}
+
+func (ms *LoanStorage) GetPaymentByReference(loanID model.LoanID, reference string) (model.Payment, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	for _, p := range ms.payments[loanID] {
+		if p.Reference == reference {
+			return p, nil
 		}
+	}
+
+	return model.Payment{}, model.ErrPaymentNotFound
+}
+
+func (ms *LoanStorage) UpdatePayment(loanID model.LoanID, payment model.Payment) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	payments := ms.payments[loanID]
+	for i := range payments {
+		if payments[i].ID == payment.ID {
+			payments[i].ReversedAt = payment.ReversedAt.UTC()
+			payments[i].ReversalReason = payment.ReversalReason
+
+			return nil
+		}
+	}
+
+	return model.ErrPaymentNotFound
+}
+
+func (ms *LoanStorage) CreateBillings(loanID model.LoanID, billings []model.Billing) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	// emulate SQL COPY with Transaction block
+	for _, b := range billings {
+		b.LoanID = loanID
+		b.PaymentDueDate = b.PaymentDueDate.UTC()
 
 		// stmt.Exec()
 		ms.billings[loanID] = append(ms.billings[loanID], b)
-
-		currentDate = currentDate.AddDate(0, 0, 7)
 	}
 
 	return nil
 }
 
-func (ms *LoanStorage) GetBillingAt(loanID model.LoanID, when time.Time) ([]model.Billing, error) {
+func (ms *LoanStorage) GetBillings(loanID model.LoanID) ([]model.Billing, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
@@ -194,7 +423,7 @@ func (ms *LoanStorage) GetBillingAt(loanID model.LoanID, when time.Time) ([]mode
 
 	ret := []model.Billing{}
 	for _, b := range billings {
-		if b.PaymentDueDate.Before(when.UTC()) {
+		if ms.isCurrent(b) {
 			ret = append(ret, b)
 		}
 	}
@@ -202,7 +431,7 @@ func (ms *LoanStorage) GetBillingAt(loanID model.LoanID, when time.Time) ([]mode
 	return ret, nil
 }
 
-func (ms *LoanStorage) GetUnfulfilledBillingAt(loanID model.LoanID, when time.Time) ([]model.Billing, error) {
+func (ms *LoanStorage) GetBillingAt(loanID model.LoanID, when time.Time) ([]model.Billing, error) {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
@@ -211,13 +440,30 @@ func (ms *LoanStorage) GetUnfulfilledBillingAt(loanID model.LoanID, when time.Ti
 		return nil, model.ErrLoanNotFound
 	}
 
-	padding := when.UTC().AddDate(0, 0, 7) // pad to a term
+	ret := []model.Billing{}
+	for _, b := range billings {
+		if ms.isCurrent(b) && b.PaymentDueDate.Before(when.UTC()) {
+			ret = append(ret, b)
+		}
+	}
+
+	return ret, nil
+}
+
+func (ms *LoanStorage) GetUnfulfilledBillingUntil(loanID model.LoanID, dueUntil time.Time) ([]model.Billing, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	billings, ok := ms.billings[loanID]
+	if !ok {
+		return nil, model.ErrLoanNotFound
+	}
 
-	// SQL WHERE due is before and not paid, sorted by due date
+	// SQL WHERE due is not after and not paid, sorted by due date
 
 	ret := []model.Billing{}
 	for _, b := range billings {
-		if b.PaymentDueDate.Before(padding) && !b.IsPaid {
+		if ms.isCurrent(b) && !b.PaymentDueDate.After(dueUntil.UTC()) && !b.IsPaid() {
 			ret = append(ret, b)
 		}
 	}
@@ -225,26 +471,98 @@ func (ms *LoanStorage) GetUnfulfilledBillingAt(loanID model.LoanID, when time.Ti
 	return ret, nil
 }
 
-func (ms *LoanStorage) PayBillingUntil(loanID model.LoanID, when time.Time) error {
+// isCurrent emulates the SQL JOIN on the terms version of the loan, `mu` is held by the caller
+func (ms *LoanStorage) isCurrent(b model.Billing) bool {
+	return b.TermsVersion == ms.loans[b.LoanID].TermsVersion
+}
+
+func (ms *LoanStorage) UpdateBillings(loanID model.LoanID, billings []model.Billing) error {
 	ms.mu.Lock()
 	defer ms.mu.Unlock()
 
-	billings, ok := ms.billings[loanID]
+	stored, ok := ms.billings[loanID]
 	if !ok {
 		return model.ErrLoanNotFound
 	}
 
-	padding := when.UTC().AddDate(0, 0, 7) // pad to a term
+	// emulate SQL UPDATE ... WHERE terms_version = ? AND term_number = ?
+	for _, b := range billings {
+		for i := range stored {
+			if stored[i].TermsVersion == b.TermsVersion && stored[i].TermNumber == b.TermNumber {
+				stored[i].Repayment = b.Repayment
+				stored[i].Principal = b.Principal
+				stored[i].Interest = b.Interest
+				stored[i].Fee = b.Fee
+				stored[i].Penalty = b.Penalty
+				stored[i].AccruedUntil = b.AccruedUntil
+				stored[i].PaidAmount = b.PaidAmount
+				stored[i].PaidPrincipal = b.PaidPrincipal
+				stored[i].PaidInterest = b.PaidInterest
+				stored[i].PaidFee = b.PaidFee
+				stored[i].PaidPenalty = b.PaidPenalty
+				stored[i].Rebate = b.Rebate
+				stored[i].DeferredAt = b.DeferredAt
+			}
+		}
+	}
 
-	// SQL WHERE due is before and not paid, sorted by due date
+	ms.billings[loanID] = stored
 
-	for i, b := range billings {
-		if b.PaymentDueDate.Before(padding) && !b.IsPaid {
-			billings[i].IsPaid = true
+	return nil
+}
+
+func (ms *LoanStorage) SupersedeBillings(loanID model.LoanID, termsVersion int, at time.Time) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	stored, ok := ms.billings[loanID]
+	if !ok {
+		return model.ErrLoanNotFound
+	}
+
+	for i := range stored {
+		if stored[i].TermsVersion == termsVersion && !stored[i].IsPaid() {
+			stored[i].SupersededAt = at.UTC()
 		}
 	}
 
-	ms.billings[loanID] = billings
+	return nil
+}
+
+func (ms *LoanStorage) GetEndOfDayRun(runKey string) (model.EndOfDayRun, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	run, ok := ms.endOfDayRuns[runKey]
+	if !ok {
+		return model.EndOfDayRun{}, model.ErrEndOfDayRunNotFound
+	}
+
+	return run, nil
+}
+
+func (ms *LoanStorage) CreateEndOfDayRun(run model.EndOfDayRun) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.endOfDayRuns[run.RunKey]; ok {
+		return model.ErrEndOfDayRunInProgress
+	}
+
+	ms.endOfDayRuns[run.RunKey] = run
+
+	return nil
+}
+
+func (ms *LoanStorage) UpdateEndOfDayRun(run model.EndOfDayRun) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.endOfDayRuns[run.RunKey]; !ok {
+		return model.ErrEndOfDayRunNotFound
+	}
+
+	ms.endOfDayRuns[run.RunKey] = run
 
 	return nil
 }
@@ -1,3 +1,1134 @@
 package sqlstorage
 
-// TODO: implement me
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib" // register "pgx" database/sql driver
+	"go.jetify.com/typeid"
+)
+
+// LoanStorage is a PostgreSQL storage adapter, the tables are modelled in `db/schema.hcl`
+type LoanStorage struct {
+	db *sql.DB
+	q  querier // `db` itself, or the running transaction inside `WithinTx`
+	tx *sql.Tx
+}
+
+// querier is satisfied by both *sql.DB and *sql.Tx
+type querier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// Open opens a connection pool to PostgreSQL using the given connection string
+func Open(databaseURL string) (*sql.DB, error) {
+	db, err := sql.Open("pgx", databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Ping()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func NewLoanSQLStorage(db *sql.DB) *LoanStorage {
+	return &LoanStorage{
+		db: db,
+		q:  db,
+	}
+}
+
+// WithinTx runs `fn` inside a database transaction, loans read through `tx` are locked until it is committed or
+// rolled back so concurrent use cases on the same loan are serialized
+func (s *LoanStorage) WithinTx(fn func(tx ports.LoanStorage) error) error {
+	// already in a transaction, join it
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = fn(&LoanStorage{db: s.db, q: tx, tx: tx})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// uniqueViolation is the SQLSTATE of a duplicate key (ref: https://www.postgresql.org/docs/current/errcodes-appendix.html)
+const uniqueViolation = "23505"
+
+// forUpdate locks the selected row of `alias` when running inside a transaction
+func (s *LoanStorage) forUpdate(alias string) string {
+	if s.tx == nil {
+		return ""
+	}
+
+	return " FOR UPDATE OF " + alias
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+const loanColumns = `l.id, l.principal, l.annual_interest_rate, l.start_date, l.total_interest,
+	l.outstanding_balance, l.is_completed, l.allocation_policy, l.credit, l.interest_method, l.rate_basis, l.day_count,
+	l.product, l.missed_payment_threshold, l.delinquency_grace_days, l.frequency, l.first_due_date, l.loan_term,
+	l.installment_amount, l.installment_interest, l.terms_version, l.written_off_at`
+
+func scanLoan(row rowScanner, extra ...any) (model.InstallmentLoan, error) {
+	var (
+		loan         model.InstallmentLoan
+		loanID       string
+		firstDueDate sql.NullTime
+		writtenOffAt sql.NullTime
+	)
+
+	dest := []any{
+		&loanID,
+		&loan.Principal,
+		&loan.AnnualInterestRate,
+		&loan.StartDate,
+		&loan.TotalInterest,
+		&loan.OutstandingBalance,
+		&loan.IsCompleted,
+		&loan.AllocationPolicy,
+		&loan.Credit,
+		&loan.InterestMethod,
+		&loan.RateBasis,
+		&loan.DayCount,
+		&loan.Product,
+		&loan.DelinquencyRule.MissedPaymentThreshold,
+		&loan.DelinquencyRule.GraceDays,
+		&loan.Frequency,
+		&firstDueDate,
+		&loan.LoanTerm,
+		&loan.InstallmentAmount,
+		&loan.InstallmentInterest,
+		&loan.TermsVersion,
+		&writtenOffAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return model.InstallmentLoan{}, err
+	}
+
+	loan.ID, err = typeid.FromUUID[model.LoanID](loanID)
+	if err != nil {
+		return model.InstallmentLoan{}, err
+	}
+	loan.StartDate = loan.StartDate.UTC()
+	if firstDueDate.Valid {
+		loan.FirstDueDate = firstDueDate.Time.UTC()
+	}
+	if writtenOffAt.Valid {
+		loan.WrittenOffAt = writtenOffAt.Time.UTC()
+	}
+
+	return loan, nil
+}
+
+func (s *LoanStorage) loanExists(loanID model.LoanID) (bool, error) {
+	var exists bool
+	err := s.q.QueryRow(`SELECT EXISTS (SELECT 1 FROM billing.loan WHERE id = $1)`, loanID.UUID()).Scan(&exists)
+
+	return exists, err
+}
+
+func (s *LoanStorage) CreateLoan(loan model.InstallmentLoan) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.loan (
+			id, currency, principal, annual_interest_rate, start_date, total_interest,
+			outstanding_balance, is_completed, allocation_policy, credit, interest_method, rate_basis, day_count,
+			product, missed_payment_threshold, delinquency_grace_days,
+			frequency, first_due_date, loan_term, installment_amount, installment_interest, terms_version, written_off_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23)`,
+		loan.ID.UUID(),
+		loan.Principal.ISOCode(),
+		loan.Principal,
+		loan.AnnualInterestRate,
+		loan.StartDate.UTC(),
+		loan.TotalInterest,
+		loan.OutstandingBalance,
+		loan.IsCompleted,
+		loan.AllocationPolicy,
+		loan.Credit,
+		loan.InterestMethod,
+		loan.RateBasis,
+		loan.DayCount,
+		loan.Product,
+		loan.DelinquencyRule.MissedPaymentThreshold,
+		loan.DelinquencyRule.GraceDays,
+		loan.Frequency,
+		nullTime(loan.FirstDueDate),
+		loan.LoanTerm,
+		loan.InstallmentAmount,
+		loan.InstallmentInterest,
+		loan.TermsVersion,
+		nullTime(loan.WrittenOffAt),
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetLoan(loanID model.LoanID) (model.InstallmentLoan, error) {
+	row := s.q.QueryRow(`SELECT `+loanColumns+` FROM billing.loan l WHERE l.id = $1`+s.forUpdate("l"), loanID.UUID())
+
+	loan, err := scanLoan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.InstallmentLoan{}, model.ErrLoanNotFound
+	}
+	if err != nil {
+		return model.InstallmentLoan{}, err
+	}
+
+	return loan, nil
+}
+
+func (s *LoanStorage) GetLoanWithDelinquency(loanID model.LoanID) (model.LoanWithDelinquency, error) {
+	row := s.q.QueryRow(`
+		SELECT `+loanColumns+`, `+delinquencyColumns+`
+		FROM billing.loan l
+		LEFT JOIN billing.delinquency_status d ON d.loan_id = l.id
+		WHERE l.id = $1`+s.forUpdate("l"),
+		loanID.UUID(),
+	)
+
+	var delinquency nullDelinquencyStatus
+	loan, err := scanLoan(row, delinquency.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.LoanWithDelinquency{}, model.ErrLoanNotFound
+	}
+	if err != nil {
+		return model.LoanWithDelinquency{}, err
+	}
+
+	if !delinquency.isDelinquent.Valid {
+		return model.LoanWithDelinquency{}, model.ErrDelinquencyStatusNotFound
+	}
+
+	ret := model.LoanWithDelinquency{
+		InstallmentLoan:   loan,
+		DelinquencyStatus: delinquency.status(loan.ID),
+	}
+
+	return ret, nil
+}
+
+const delinquencyColumns = `d.is_delinquent, d.late_fee, d.days_past_due, d.bucket, d.kolektibilitas, d.aged_at,
+	d.delinquent_at, d.cured_at`
+
+// nullDelinquencyStatus scans `delinquencyColumns`, every column is null when the status is missing from the join
+type nullDelinquencyStatus struct {
+	isDelinquent   sql.NullBool
+	lateFee        sql.NullInt64
+	daysPastDue    sql.NullInt64
+	bucket         sql.NullString
+	kolektibilitas sql.NullInt64
+	agedAt         sql.NullTime
+	delinquentAt   sql.NullTime
+	curedAt        sql.NullTime
+}
+
+func (d *nullDelinquencyStatus) dest() []any {
+	return []any{&d.isDelinquent, &d.lateFee, &d.daysPastDue, &d.bucket, &d.kolektibilitas, &d.agedAt, &d.delinquentAt, &d.curedAt}
+}
+
+func (d *nullDelinquencyStatus) status(loanID model.LoanID) model.DelinquencyStatus {
+	status := model.DelinquencyStatus{
+		LoanID:         loanID,
+		IsDelinquent:   d.isDelinquent.Bool,
+		LateFee:        currency.Rupiah(d.lateFee.Int64),
+		DaysPastDue:    int(d.daysPastDue.Int64),
+		Bucket:         model.Bucket(d.bucket.String),
+		Kolektibilitas: model.Kolektibilitas(d.kolektibilitas.Int64),
+	}
+	if d.agedAt.Valid {
+		status.AgedAt = d.agedAt.Time.UTC()
+	}
+	if d.delinquentAt.Valid {
+		status.DelinquentAt = d.delinquentAt.Time.UTC()
+	}
+	if d.curedAt.Valid {
+		status.CuredAt = d.curedAt.Time.UTC()
+	}
+
+	return status
+}
+
+func (s *LoanStorage) GetLoanFullInformation(loanID model.LoanID) (model.LoanFullInformation, error) {
+	loan, err := s.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return model.LoanFullInformation{}, err
+	}
+
+	rows, err := s.q.Query(`
+		SELECT `+paymentColumns+`
+		FROM billing.payment
+		WHERE loan_id = $1
+		ORDER BY date`,
+		loanID.UUID(),
+	)
+	if err != nil {
+		return model.LoanFullInformation{}, err
+	}
+	defer rows.Close()
+
+	var payments []model.Payment
+	for rows.Next() {
+		p, err := scanPayment(rows, loanID)
+		if err != nil {
+			return model.LoanFullInformation{}, err
+		}
+		payments = append(payments, p)
+	}
+	if err = rows.Err(); err != nil {
+		return model.LoanFullInformation{}, err
+	}
+
+	ret := model.LoanFullInformation{
+		InstallmentLoan:   loan.InstallmentLoan,
+		DelinquencyStatus: loan.DelinquencyStatus,
+		Payments:          payments,
+	}
+
+	return ret, nil
+}
+
+func (s *LoanStorage) ListActiveLoans(after model.LoanID, limit int) ([]model.LoanID, error) {
+	rows, err := s.q.Query(`
+		SELECT id
+		FROM billing.loan
+		WHERE NOT is_completed AND written_off_at IS NULL AND id > $1
+		ORDER BY id
+		LIMIT $2`,
+		after.UUID(),
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := []model.LoanID{}
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		loanID, err := typeid.FromUUID[model.LoanID](id)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, loanID)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *LoanStorage) UpdateLoan(loanID model.LoanID, updateParams model.InstallmentLoan) error {
+	res, err := s.q.Exec(`
+		UPDATE billing.loan SET
+			principal = $2,
+			annual_interest_rate = $3,
+			start_date = $4,
+			total_interest = $5,
+			outstanding_balance = $6,
+			is_completed = $7,
+			allocation_policy = $8,
+			credit = $9,
+			interest_method = $10,
+			rate_basis = $11,
+			day_count = $12,
+			frequency = $13,
+			loan_term = $14,
+			installment_amount = $15,
+			installment_interest = $16,
+			product = $17,
+			missed_payment_threshold = $18,
+			delinquency_grace_days = $19,
+			first_due_date = $20,
+			terms_version = $21,
+			written_off_at = $22
+		WHERE id = $1`,
+		loanID.UUID(),
+		updateParams.Principal,
+		updateParams.AnnualInterestRate,
+		updateParams.StartDate.UTC(),
+		updateParams.TotalInterest,
+		updateParams.OutstandingBalance,
+		updateParams.IsCompleted,
+		updateParams.AllocationPolicy,
+		updateParams.Credit,
+		updateParams.InterestMethod,
+		updateParams.RateBasis,
+		updateParams.DayCount,
+		updateParams.Frequency,
+		updateParams.LoanTerm,
+		updateParams.InstallmentAmount,
+		updateParams.InstallmentInterest,
+		updateParams.Product,
+		updateParams.DelinquencyRule.MissedPaymentThreshold,
+		updateParams.DelinquencyRule.GraceDays,
+		nullTime(updateParams.FirstDueDate),
+		updateParams.TermsVersion,
+		nullTime(updateParams.WrittenOffAt),
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res, model.ErrLoanNotFound)
+}
+
+const loanTermsColumns = `version, start_date, superseded_at, principal, annual_interest_rate, frequency, first_due_date,
+	loan_term, interest_method, rate_basis, day_count, total_interest, installment_amount`
+
+func (s *LoanStorage) RecordLoanTerms(loanID model.LoanID, terms model.LoanTerms) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.loan_terms (
+			id, loan_id, `+loanTermsColumns+`
+		) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		loanID.UUID(),
+		terms.Version,
+		terms.StartDate.UTC(),
+		nullTime(terms.SupersededAt),
+		terms.Principal,
+		terms.AnnualInterestRate,
+		terms.Frequency,
+		nullTime(terms.FirstDueDate),
+		terms.LoanTerm,
+		terms.InterestMethod,
+		terms.RateBasis,
+		terms.DayCount,
+		terms.TotalInterest,
+		terms.InstallmentAmount,
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetLoanTerms(loanID model.LoanID) ([]model.LoanTerms, error) {
+	rows, err := s.q.Query(`
+		SELECT `+loanTermsColumns+`
+		FROM billing.loan_terms
+		WHERE loan_id = $1
+		ORDER BY version`,
+		loanID.UUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := []model.LoanTerms{}
+	for rows.Next() {
+		var (
+			t            = model.LoanTerms{LoanID: loanID}
+			supersededAt sql.NullTime
+			firstDueDate sql.NullTime
+		)
+		err = rows.Scan(
+			&t.Version,
+			&t.StartDate,
+			&supersededAt,
+			&t.Principal,
+			&t.AnnualInterestRate,
+			&t.Frequency,
+			&firstDueDate,
+			&t.LoanTerm,
+			&t.InterestMethod,
+			&t.RateBasis,
+			&t.DayCount,
+			&t.TotalInterest,
+			&t.InstallmentAmount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		t.StartDate = t.StartDate.UTC()
+		if supersededAt.Valid {
+			t.SupersededAt = supersededAt.Time.UTC()
+		}
+		if firstDueDate.Valid {
+			t.FirstDueDate = firstDueDate.Time.UTC()
+		}
+		ret = append(ret, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ret) == 0 {
+		exists, err := s.loanExists(loanID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, model.ErrLoanNotFound
+		}
+	}
+
+	return ret, nil
+}
+
+func (s *LoanStorage) CreateDelinquencyStatus(loanID model.LoanID, delinquencyStatus model.DelinquencyStatus) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.delinquency_status (
+			id, loan_id, is_delinquent, late_fee, days_past_due, bucket, kolektibilitas, aged_at, delinquent_at, cured_at
+		) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		loanID.UUID(),
+		delinquencyStatus.IsDelinquent,
+		delinquencyStatus.LateFee,
+		delinquencyStatus.DaysPastDue,
+		delinquencyStatus.Bucket,
+		delinquencyStatus.Kolektibilitas,
+		nullTime(delinquencyStatus.AgedAt),
+		nullTime(delinquencyStatus.DelinquentAt),
+		nullTime(delinquencyStatus.CuredAt),
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetDelinquencyStatus(loanID model.LoanID) (model.DelinquencyStatus, error) {
+	var delinquency nullDelinquencyStatus
+
+	err := s.q.QueryRow(`SELECT `+delinquencyColumns+` FROM billing.delinquency_status d WHERE d.loan_id = $1`,
+		loanID.UUID(),
+	).Scan(delinquency.dest()...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.DelinquencyStatus{}, model.ErrLoanNotFound
+	}
+	if err != nil {
+		return model.DelinquencyStatus{}, err
+	}
+
+	return delinquency.status(loanID), nil
+}
+
+func (s *LoanStorage) UpdateDelinquencyStatus(loanID model.LoanID, updateParams model.DelinquencyStatus) error {
+	res, err := s.q.Exec(`
+		UPDATE billing.delinquency_status SET
+			is_delinquent = $2,
+			late_fee = $3,
+			days_past_due = $4,
+			bucket = $5,
+			kolektibilitas = $6,
+			aged_at = $7,
+			delinquent_at = $8,
+			cured_at = $9
+		WHERE loan_id = $1`,
+		loanID.UUID(),
+		updateParams.IsDelinquent,
+		updateParams.LateFee,
+		updateParams.DaysPastDue,
+		updateParams.Bucket,
+		updateParams.Kolektibilitas,
+		nullTime(updateParams.AgedAt),
+		nullTime(updateParams.DelinquentAt),
+		nullTime(updateParams.CuredAt),
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res, model.ErrLoanNotFound)
+}
+
+func (s *LoanStorage) RecordDelinquencyEvent(loanID model.LoanID, event model.DelinquencyEvent) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.delinquency_event (
+			id, loan_id, kind, at, missed_billings
+		) VALUES (gen_random_uuid(), $1, $2, $3, $4)`,
+		loanID.UUID(),
+		event.Kind,
+		event.At.UTC(),
+		event.MissedBillings,
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetDelinquencyEvents(loanID model.LoanID) ([]model.DelinquencyEvent, error) {
+	rows, err := s.q.Query(`
+		SELECT kind, at, missed_billings
+		FROM billing.delinquency_event
+		WHERE loan_id = $1
+		ORDER BY at`,
+		loanID.UUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []model.DelinquencyEvent
+	for rows.Next() {
+		e := model.DelinquencyEvent{LoanID: loanID}
+		err = rows.Scan(&e.Kind, &e.At, &e.MissedBillings)
+		if err != nil {
+			return nil, err
+		}
+		e.At = e.At.UTC()
+		events = append(events, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		exists, err := s.loanExists(loanID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, model.ErrLoanNotFound
+		}
+	}
+
+	return events, nil
+}
+
+func (s *LoanStorage) RecordDeferralEvent(loanID model.LoanID, event model.DeferralEvent) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.deferral_event (
+			id, loan_id, at, from_term, periods, method, deferred_amount, reason
+		) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7)`,
+		loanID.UUID(),
+		event.At.UTC(),
+		event.FromTerm,
+		event.Periods,
+		event.Method,
+		event.DeferredAmount,
+		event.Reason,
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetDeferralEvents(loanID model.LoanID) ([]model.DeferralEvent, error) {
+	rows, err := s.q.Query(`
+		SELECT at, from_term, periods, method, deferred_amount, reason
+		FROM billing.deferral_event
+		WHERE loan_id = $1
+		ORDER BY at`,
+		loanID.UUID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []model.DeferralEvent
+	for rows.Next() {
+		e := model.DeferralEvent{LoanID: loanID}
+		err = rows.Scan(&e.At, &e.FromTerm, &e.Periods, &e.Method, &e.DeferredAmount, &e.Reason)
+		if err != nil {
+			return nil, err
+		}
+		e.At = e.At.UTC()
+		events = append(events, e)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		exists, err := s.loanExists(loanID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, model.ErrLoanNotFound
+		}
+	}
+
+	return events, nil
+}
+
+func (s *LoanStorage) CreateWriteOff(loanID model.LoanID, writeOff model.WriteOff) error {
+	_, err := s.q.Exec(`
+		INSERT INTO billing.write_off (
+			id, loan_id, at, principal, interest, fee, penalty, reason
+		) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7)`,
+		loanID.UUID(),
+		writeOff.At.UTC(),
+		writeOff.Principal,
+		writeOff.Interest,
+		writeOff.Fee,
+		writeOff.Penalty,
+		writeOff.Reason,
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetWriteOff(loanID model.LoanID) (model.WriteOff, error) {
+	w := model.WriteOff{LoanID: loanID}
+	err := s.q.QueryRow(`
+		SELECT at, principal, interest, fee, penalty, reason
+		FROM billing.write_off
+		WHERE loan_id = $1`,
+		loanID.UUID(),
+	).Scan(&w.At, &w.Principal, &w.Interest, &w.Fee, &w.Penalty, &w.Reason)
+	if errors.Is(err, sql.ErrNoRows) {
+		exists, err := s.loanExists(loanID)
+		if err != nil {
+			return model.WriteOff{}, err
+		}
+		if !exists {
+			return model.WriteOff{}, model.ErrLoanNotFound
+		}
+
+		return model.WriteOff{}, model.ErrWriteOffNotFound
+	}
+	if err != nil {
+		return model.WriteOff{}, err
+	}
+	w.At = w.At.UTC()
+
+	return w, nil
+}
+
+const paymentColumns = `id, kind, reference, date, amount, balance_before, balance_after, principal, interest, fee,
+	penalty, rebate, reversed_at, reversal_reason`
+
+func scanPayment(row rowScanner, loanID model.LoanID) (model.Payment, error) {
+	var (
+		p              = model.Payment{LoanID: loanID}
+		paymentID      string
+		reference      sql.NullString
+		reversedAt     sql.NullTime
+		reversalReason sql.NullString
+	)
+
+	err := row.Scan(&paymentID, &p.Kind, &reference, &p.Date, &p.Amount, &p.BalanceBefore, &p.BalanceAfter, &p.Principal, &p.Interest, &p.Fee, &p.Penalty,
+		&p.Rebate, &reversedAt, &reversalReason)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	p.ID, err = typeid.FromUUID[model.PaymentID](paymentID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+	p.Reference = reference.String
+	p.Date = p.Date.UTC()
+	if reversedAt.Valid {
+		p.ReversedAt = reversedAt.Time.UTC()
+	}
+	p.ReversalReason = reversalReason.String
+
+	return p, nil
+}
+
+func (s *LoanStorage) GetPayment(loanID model.LoanID, paymentID model.PaymentID) (model.Payment, error) {
+	row := s.q.QueryRow(`SELECT `+paymentColumns+` FROM billing.payment WHERE loan_id = $1 AND id = $2`,
+		loanID.UUID(),
+		paymentID.UUID(),
+	)
+
+	payment, err := scanPayment(row, loanID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Payment{}, model.ErrPaymentNotFound
+	}
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (s *LoanStorage) GetPaymentByReference(loanID model.LoanID, reference string) (model.Payment, error) {
+	row := s.q.QueryRow(`SELECT `+paymentColumns+` FROM billing.payment WHERE loan_id = $1 AND reference = $2`,
+		loanID.UUID(),
+		reference,
+	)
+
+	payment, err := scanPayment(row, loanID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.Payment{}, model.ErrPaymentNotFound
+	}
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (s *LoanStorage) RecordPayment(loanID model.LoanID, payment model.Payment) error {
+	// no reference is stored as NULL, which is never unique
+	reference := sql.NullString{String: payment.Reference, Valid: payment.Reference != ""}
+
+	_, err := s.q.Exec(`
+		INSERT INTO billing.payment (
+			id, loan_id, kind, reference, date, amount, balance_before, balance_after, principal, interest, fee, penalty,
+			rebate
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		payment.ID.UUID(),
+		loanID.UUID(),
+		payment.Kind,
+		reference,
+		payment.Date.UTC(),
+		payment.Amount,
+		payment.BalanceBefore,
+		payment.BalanceAfter,
+		payment.Principal,
+		payment.Interest,
+		payment.Fee,
+		payment.Penalty,
+		payment.Rebate,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "payment_loan_id_reference" {
+		return model.ErrIdempotencyKeyConflict
+	}
+
+	return err
+}
+
+func (s *LoanStorage) UpdatePayment(loanID model.LoanID, payment model.Payment) error {
+	res, err := s.q.Exec(`
+		UPDATE billing.payment SET
+			reversed_at = $3,
+			reversal_reason = $4
+		WHERE loan_id = $1 AND id = $2`,
+		loanID.UUID(),
+		payment.ID.UUID(),
+		nullTime(payment.ReversedAt),
+		sql.NullString{String: payment.ReversalReason, Valid: payment.ReversalReason != ""},
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res, model.ErrPaymentNotFound)
+}
+
+func (s *LoanStorage) CreateBillings(loanID model.LoanID, billings []model.Billing) error {
+	// atomic within the unit of work that creates the loan
+	for _, b := range billings {
+		_, err := s.q.Exec(`
+			INSERT INTO billing.billing (
+				id, loan_id, terms_version, term_number, payment_due_date, repayment, principal, interest, fee, penalty,
+				paid_amount, paid_principal, paid_interest, paid_fee, paid_penalty, rebate, deferred_at
+			) VALUES (gen_random_uuid(), $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+			loanID.UUID(),
+			b.TermsVersion,
+			b.TermNumber,
+			b.PaymentDueDate.UTC(),
+			b.Repayment,
+			b.Principal,
+			b.Interest,
+			b.Fee,
+			b.Penalty,
+			b.PaidAmount,
+			b.PaidPrincipal,
+			b.PaidInterest,
+			b.PaidFee,
+			b.PaidPenalty,
+			b.Rebate,
+			nullTime(b.DeferredAt),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const billingColumns = `terms_version, term_number, payment_due_date, repayment, principal, interest, fee, penalty,
+	paid_amount, paid_principal, paid_interest, paid_fee, paid_penalty, rebate, accrued_until, superseded_at,
+	deferred_at`
+
+func (s *LoanStorage) queryBillings(loanID model.LoanID, query string, args ...any) ([]model.Billing, error) {
+	rows, err := s.q.Query(query, append([]any{loanID.UUID()}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ret := []model.Billing{}
+	for rows.Next() {
+		var (
+			b            = model.Billing{LoanID: loanID}
+			accruedUntil sql.NullTime
+			supersededAt sql.NullTime
+			deferredAt   sql.NullTime
+		)
+		err = rows.Scan(
+			&b.TermsVersion,
+			&b.TermNumber,
+			&b.PaymentDueDate,
+			&b.Repayment,
+			&b.Principal,
+			&b.Interest,
+			&b.Fee,
+			&b.Penalty,
+			&b.PaidAmount,
+			&b.PaidPrincipal,
+			&b.PaidInterest,
+			&b.PaidFee,
+			&b.PaidPenalty,
+			&b.Rebate,
+			&accruedUntil,
+			&supersededAt,
+			&deferredAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		b.PaymentDueDate = b.PaymentDueDate.UTC()
+		if accruedUntil.Valid {
+			b.AccruedUntil = accruedUntil.Time.UTC()
+		}
+		if supersededAt.Valid {
+			b.SupersededAt = supersededAt.Time.UTC()
+		}
+		if deferredAt.Valid {
+			b.DeferredAt = deferredAt.Time.UTC()
+		}
+		ret = append(ret, b)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ret) == 0 {
+		exists, err := s.loanExists(loanID)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, model.ErrLoanNotFound
+		}
+	}
+
+	return ret, nil
+}
+
+// currentTermsVersion only keeps the billings scheduled by the current terms of the loan `$1`
+const currentTermsVersion = `SELECT terms_version FROM billing.loan WHERE id = $1`
+
+func (s *LoanStorage) GetBillings(loanID model.LoanID) ([]model.Billing, error) {
+	return s.queryBillings(loanID, `
+		SELECT `+billingColumns+`
+		FROM billing.billing
+		WHERE loan_id = $1 AND terms_version = (`+currentTermsVersion+`)
+		ORDER BY term_number`,
+	)
+}
+
+func (s *LoanStorage) GetUnfulfilledBillingUntil(loanID model.LoanID, dueUntil time.Time) ([]model.Billing, error) {
+	return s.queryBillings(loanID, `
+		SELECT `+billingColumns+`
+		FROM billing.billing
+		WHERE loan_id = $1 AND terms_version = (`+currentTermsVersion+`) AND payment_due_date <= $2
+			AND paid_amount + rebate < repayment
+		ORDER BY payment_due_date`,
+		dueUntil.UTC(),
+	)
+}
+
+func (s *LoanStorage) UpdateBillings(loanID model.LoanID, billings []model.Billing) error {
+	for _, b := range billings {
+		res, err := s.q.Exec(`
+			UPDATE billing.billing SET
+				repayment = $3,
+				fee = $4,
+				penalty = $5,
+				paid_amount = $6,
+				paid_principal = $7,
+				paid_interest = $8,
+				paid_fee = $9,
+				paid_penalty = $10,
+				accrued_until = $11,
+				principal = $13,
+				interest = $14,
+				deferred_at = $15,
+				rebate = $16
+			WHERE loan_id = $1 AND term_number = $2 AND terms_version = $12`,
+			loanID.UUID(),
+			b.TermNumber,
+			b.Repayment,
+			b.Fee,
+			b.Penalty,
+			b.PaidAmount,
+			b.PaidPrincipal,
+			b.PaidInterest,
+			b.PaidFee,
+			b.PaidPenalty,
+			nullTime(b.AccruedUntil),
+			b.TermsVersion,
+			b.Principal,
+			b.Interest,
+			nullTime(b.DeferredAt),
+			b.Rebate,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = expectAffected(res, model.ErrLoanNotFound)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *LoanStorage) SupersedeBillings(loanID model.LoanID, termsVersion int, at time.Time) error {
+	_, err := s.q.Exec(`
+		UPDATE billing.billing SET superseded_at = $3
+		WHERE loan_id = $1 AND terms_version = $2 AND paid_amount + rebate < repayment`,
+		loanID.UUID(),
+		termsVersion,
+		at.UTC(),
+	)
+
+	return err
+}
+
+func (s *LoanStorage) GetEndOfDayRun(runKey string) (model.EndOfDayRun, error) {
+	var (
+		run        = model.EndOfDayRun{RunKey: runKey}
+		finishedAt sql.NullTime
+		checkpoint sql.NullString
+	)
+
+	err := s.q.QueryRow(`
+		SELECT r.business_date, r.started_at, r.finished_at, r.checkpoint, r.processed, r.delinquent, r.charged
+		FROM billing.end_of_day_run r
+		WHERE r.run_key = $1`+s.forUpdate("r"),
+		runKey,
+	).Scan(&run.BusinessDate, &run.StartedAt, &finishedAt, &checkpoint, &run.Processed, &run.Delinquent, &run.Charged)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.EndOfDayRun{}, model.ErrEndOfDayRunNotFound
+	}
+	if err != nil {
+		return model.EndOfDayRun{}, err
+	}
+
+	run.BusinessDate = model.BusinessDate(run.BusinessDate)
+	run.StartedAt = run.StartedAt.UTC()
+	if finishedAt.Valid {
+		run.FinishedAt = finishedAt.Time.UTC()
+	}
+	if checkpoint.Valid {
+		run.Checkpoint, err = typeid.FromUUID[model.LoanID](checkpoint.String)
+		if err != nil {
+			return model.EndOfDayRun{}, err
+		}
+	}
+
+	return run, nil
+}
+
+func (s *LoanStorage) CreateEndOfDayRun(run model.EndOfDayRun) error {
+	var checkpoint sql.NullString
+	if !run.Checkpoint.IsZero() {
+		checkpoint = sql.NullString{String: run.Checkpoint.UUID(), Valid: true}
+	}
+
+	_, err := s.q.Exec(`
+		INSERT INTO billing.end_of_day_run (
+			run_key, business_date, started_at, finished_at, checkpoint, processed, delinquent, charged
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		run.RunKey,
+		run.BusinessDate.UTC(),
+		run.StartedAt.UTC(),
+		nullTime(run.FinishedAt),
+		checkpoint,
+		run.Processed,
+		run.Delinquent,
+		run.Charged,
+	)
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "end_of_day_run_pkey" {
+		return model.ErrEndOfDayRunInProgress
+	}
+
+	return err
+}
+
+func (s *LoanStorage) UpdateEndOfDayRun(run model.EndOfDayRun) error {
+	var checkpoint sql.NullString
+	if !run.Checkpoint.IsZero() {
+		checkpoint = sql.NullString{String: run.Checkpoint.UUID(), Valid: true}
+	}
+
+	res, err := s.q.Exec(`
+		UPDATE billing.end_of_day_run SET
+			finished_at = $2,
+			checkpoint = $3,
+			processed = $4,
+			delinquent = $5,
+			charged = $6
+		WHERE run_key = $1`,
+		run.RunKey,
+		nullTime(run.FinishedAt),
+		checkpoint,
+		run.Processed,
+		run.Delinquent,
+		run.Charged,
+	)
+	if err != nil {
+		return err
+	}
+
+	return expectAffected(res, model.ErrEndOfDayRunNotFound)
+}
+
+// nullTime stores the zero time as NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t.UTC(), Valid: !t.IsZero()}
+}
+
+// expectAffected returns `notFound` when the statement did not touch any row
+func expectAffected(res sql.Result, notFound error) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return notFound
+	}
+
+	return nil
+}
@@ -0,0 +1,374 @@
+package sqlstorage_test
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/sqlstorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+	"go.jetify.com/typeid"
+)
+
+// openTestDB connects to the database pointed by `TEST_DATABASE_URL`, the schema in `db/schema.hcl` has to be applied
+// beforehand (see `make test-integration`)
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	databaseURL := os.Getenv("TEST_DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("TEST_DATABASE_URL is not set, skipping postgres integration test")
+	}
+
+	db, err := sqlstorage.Open(databaseURL)
+	if err != nil {
+		t.Fatalf("fail to open test database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestLoanStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := sqlstorage.NewLoanSQLStorage(openTestDB(t))
+
+	loanID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	now := time.Now().UTC().Truncate(time.Microsecond) // postgres timestamp precision
+	weeklyLoan := model.InstallmentLoan{
+		Loan: model.Loan{
+			ID:                 loanID,
+			Principal:          currency.NewRupiah(5000000, 0),
+			AnnualInterestRate: model.BPS(1000),
+			StartDate:          now,
+			TotalInterest:      currency.NewRupiah(500000, 0),
+			OutstandingBalance: currency.NewRupiah(5500000, 0),
+			AllocationPolicy:   model.AllocationApplyToFuture,
+			InterestMethod:     model.InterestFlat,
+			RateBasis:          model.RatePerTenor,
+			DayCount:           model.DayCountAct365,
+			Product:            "paylater",
+			DelinquencyRule:    model.DelinquencyRule{MissedPaymentThreshold: 2, GraceDays: 3},
+		},
+		Frequency:           model.FrequencyWeekly,
+		FirstDueDate:        now.AddDate(0, 0, 7),
+		LoanTerm:            50,
+		InstallmentAmount:   currency.NewRupiah(110000, 0),
+		InstallmentInterest: currency.NewRupiah(10000, 0),
+		TermsVersion:        1,
+	}
+
+	g.Expect(storage.CreateLoan(weeklyLoan)).To(Succeed())
+	g.Expect(storage.CreateDelinquencyStatus(loanID, model.DelinquencyStatus{LoanID: loanID})).To(Succeed())
+
+	billings := []model.Billing{}
+	for term := 1; term <= weeklyLoan.LoanTerm; term++ {
+		billings = append(billings, model.Billing{
+			TermsVersion:   1,
+			TermNumber:     term,
+			PaymentDueDate: weeklyLoan.DueDate(term),
+			Repayment:      weeklyLoan.InstallmentAmount,
+			Principal:      weeklyLoan.InstallmentAmount.Subtract(weeklyLoan.InstallmentInterest),
+			Interest:       weeklyLoan.InstallmentInterest,
+		})
+	}
+	g.Expect(storage.CreateBillings(loanID, billings)).To(Succeed())
+
+	actual, err := storage.GetLoan(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(actual).To(Equal(weeklyLoan))
+
+	withDelinquency, err := storage.GetLoanWithDelinquency(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(withDelinquency.IsDelinquent).To(BeFalse())
+
+	active, err := storage.ListActiveLoans(model.LoanID{}, math.MaxInt32)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(active).To(ContainElement(loanID))
+
+	unfulfilled, err := storage.GetUnfulfilledBillingUntil(loanID, now.AddDate(0, 0, 14))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(unfulfilled).To(HaveLen(2))
+	g.Expect(unfulfilled[0].TermNumber).To(Equal(1))
+	g.Expect(unfulfilled[0].PaymentDueDate).To(Equal(now.AddDate(0, 0, 7)))
+
+	unfulfilled[0].PaidAmount = unfulfilled[0].Repayment
+	unfulfilled[0].PaidPrincipal = unfulfilled[0].Principal
+	unfulfilled[0].PaidInterest = unfulfilled[0].Interest
+	unfulfilled[1].PaidAmount = currency.NewRupiah(50000, 0)
+	unfulfilled[1].PaidPrincipal = currency.NewRupiah(40000, 0)
+	unfulfilled[1].PaidInterest = currency.NewRupiah(10000, 0)
+	g.Expect(storage.UpdateBillings(loanID, unfulfilled)).To(Succeed())
+
+	unfulfilled, err = storage.GetUnfulfilledBillingUntil(loanID, now.AddDate(0, 0, 14))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(unfulfilled).To(HaveLen(1))
+	g.Expect(unfulfilled[0].TermNumber).To(Equal(2))
+	g.Expect(unfulfilled[0].Remaining()).To(Equal(currency.NewRupiah(60000, 0)))
+	g.Expect(unfulfilled[0].PaidPrincipal).To(Equal(currency.NewRupiah(40000, 0)))
+	g.Expect(unfulfilled[0].PaidInterest).To(Equal(currency.NewRupiah(10000, 0)))
+
+	paymentID, err := typeid.New[model.PaymentID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	payment := model.Payment{
+		ID:            paymentID,
+		LoanID:        loanID,
+		Kind:          model.PaymentRepayment,
+		Reference:     "trx-1",
+		Date:          now.AddDate(0, 0, 8),
+		Amount:        currency.NewRupiah(220000, 0),
+		BalanceBefore: weeklyLoan.OutstandingBalance,
+		BalanceAfter:  weeklyLoan.OutstandingBalance.Subtract(currency.NewRupiah(220000, 0)),
+		Principal:     currency.NewRupiah(200000, 0),
+		Interest:      currency.NewRupiah(20000, 0),
+	}
+	g.Expect(storage.RecordPayment(loanID, payment)).To(Succeed())
+
+	replayed, err := storage.GetPaymentByReference(loanID, "trx-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(replayed).To(Equal(payment))
+
+	_, err = storage.GetPaymentByReference(loanID, "trx-2")
+	g.Expect(err).To(Equal(model.ErrPaymentNotFound))
+
+	duplicateID, err := typeid.New[model.PaymentID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	duplicate := payment
+	duplicate.ID = duplicateID
+	g.Expect(storage.RecordPayment(loanID, duplicate)).To(Equal(model.ErrIdempotencyKeyConflict))
+	g.Expect(storage.UpdatePayment(loanID, duplicate)).To(Equal(model.ErrPaymentNotFound))
+
+	payment.ReversedAt = now.AddDate(0, 0, 9)
+	payment.ReversalReason = "bounced"
+	g.Expect(storage.UpdatePayment(loanID, payment)).To(Succeed())
+
+	reversed, err := storage.GetPayment(loanID, paymentID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(reversed).To(Equal(payment))
+
+	delinquencyEvent := model.DelinquencyEvent{LoanID: loanID, Kind: model.DelinquencyEventDelinquent, At: now.AddDate(0, 0, 15), MissedBillings: 2}
+	g.Expect(storage.RecordDelinquencyEvent(loanID, delinquencyEvent)).To(Succeed())
+	g.Expect(storage.UpdateDelinquencyStatus(loanID, model.DelinquencyStatus{LoanID: loanID, IsDelinquent: true, DelinquentAt: delinquencyEvent.At})).To(Succeed())
+
+	events, err := storage.GetDelinquencyEvents(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(events).To(Equal([]model.DelinquencyEvent{delinquencyEvent}))
+
+	full, err := storage.GetLoanFullInformation(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(full.IsDelinquent).To(BeTrue())
+	g.Expect(full.DelinquentAt).To(Equal(delinquencyEvent.At))
+	g.Expect(full.Payments).To(Equal([]model.Payment{payment}))
+
+	// restructured into a single installment
+	superseded := weeklyLoan.Terms()
+	superseded.SupersededAt = now.AddDate(0, 0, 16)
+	g.Expect(storage.RecordLoanTerms(loanID, superseded)).To(Succeed())
+	g.Expect(storage.SupersedeBillings(loanID, 1, superseded.SupersededAt)).To(Succeed())
+
+	// the terms version is not bumped yet, the superseded billings are still read
+	closed, err := storage.GetBillings(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(closed).ToNot(BeEmpty())
+	g.Expect(closed[0].SupersededAt).To(BeZero())
+	for _, b := range closed[1:] {
+		g.Expect(b.SupersededAt).To(Equal(superseded.SupersededAt))
+	}
+
+	restructured := weeklyLoan
+	restructured.StartDate = superseded.SupersededAt
+	restructured.FirstDueDate = time.Time{}
+	restructured.LoanTerm = 1
+	restructured.TermsVersion = 2
+	g.Expect(storage.UpdateLoan(loanID, restructured)).To(Succeed())
+	g.Expect(storage.CreateBillings(loanID, []model.Billing{{
+		TermsVersion:   2,
+		TermNumber:     1,
+		PaymentDueDate: restructured.DueDate(1),
+		Repayment:      currency.NewRupiah(5000000, 0),
+		Principal:      currency.NewRupiah(5000000, 0),
+	}})).To(Succeed())
+
+	terms, err := storage.GetLoanTerms(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(terms).To(Equal([]model.LoanTerms{superseded}))
+
+	current, err := storage.GetBillings(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(current).To(HaveLen(1))
+	g.Expect(current[0].TermsVersion).To(Equal(2))
+	g.Expect(current[0].Repayment).To(Equal(currency.NewRupiah(5000000, 0)))
+
+	// a payment holiday over the only installment
+	deferralEvent := model.DeferralEvent{
+		LoanID:         loanID,
+		At:             now.AddDate(0, 0, 17),
+		FromTerm:       1,
+		Periods:        1,
+		Method:         model.DeferralSpread,
+		DeferredAmount: currency.NewRupiah(5000000, 0),
+		Reason:         "flood relief",
+	}
+	current[0].Repayment = currency.NewRupiah(0, 0)
+	current[0].Principal = currency.NewRupiah(0, 0)
+	current[0].DeferredAt = deferralEvent.At
+	g.Expect(storage.UpdateBillings(loanID, current)).To(Succeed())
+	g.Expect(storage.RecordDeferralEvent(loanID, deferralEvent)).To(Succeed())
+
+	deferred, err := storage.GetBillings(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deferred).To(Equal(current))
+
+	deferrals, err := storage.GetDeferralEvents(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(deferrals).To(Equal([]model.DeferralEvent{deferralEvent}))
+
+	_, err = storage.GetWriteOff(loanID)
+	g.Expect(err).To(Equal(model.ErrWriteOffNotFound))
+
+	writeOff := model.WriteOff{
+		LoanID:    loanID,
+		At:        now.AddDate(0, 0, 18),
+		Principal: currency.NewRupiah(5000000, 0),
+		Reason:    "deceased",
+	}
+	g.Expect(storage.CreateWriteOff(loanID, writeOff)).To(Succeed())
+
+	restructured.WrittenOffAt = writeOff.At
+	g.Expect(storage.UpdateLoan(loanID, restructured)).To(Succeed())
+
+	writtenOff, err := storage.GetWriteOff(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(writtenOff).To(Equal(writeOff))
+
+	actual, err = storage.GetLoan(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(actual.WrittenOffAt).To(Equal(writeOff.At))
+
+	active, err = storage.ListActiveLoans(model.LoanID{}, math.MaxInt32)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(active).ToNot(ContainElement(loanID))
+
+	randoID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = storage.GetLoan(randoID)
+	g.Expect(err).To(Equal(model.ErrLoanNotFound))
+
+	_, err = storage.GetUnfulfilledBillingUntil(randoID, now)
+	g.Expect(err).To(Equal(model.ErrLoanNotFound))
+}
+
+func TestEndOfDayRun(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := sqlstorage.NewLoanSQLStorage(openTestDB(t))
+
+	// a business date of its own, a run is created once per business date
+	businessDate := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, rand.IntN(300000))
+	run := model.EndOfDayRun{
+		RunKey:       model.EndOfDayRunKey(businessDate),
+		BusinessDate: businessDate,
+		StartedAt:    businessDate.AddDate(0, 0, 1),
+	}
+	g.Expect(storage.CreateEndOfDayRun(run)).To(Succeed())
+	g.Expect(storage.CreateEndOfDayRun(run)).To(MatchError(model.ErrEndOfDayRunInProgress))
+
+	checkpoint, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	run.Checkpoint = checkpoint
+	run.Processed = 1
+	run.Delinquent = 1
+	run.Charged = currency.NewRupiah(5000, 0)
+	run.FinishedAt = run.StartedAt.Add(time.Minute)
+	g.Expect(storage.UpdateEndOfDayRun(run)).To(Succeed())
+
+	actual, err := storage.GetEndOfDayRun(run.RunKey)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(actual).To(Equal(run))
+
+	_, err = storage.GetEndOfDayRun("1970-01-01")
+	g.Expect(err).To(Equal(model.ErrEndOfDayRunNotFound))
+
+	run.RunKey = "1970-01-01"
+	g.Expect(storage.UpdateEndOfDayRun(run)).To(MatchError(model.ErrEndOfDayRunNotFound))
+}
+
+func TestWithinTx(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := sqlstorage.NewLoanSQLStorage(openTestDB(t))
+
+	loanID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	weeklyLoan := model.InstallmentLoan{
+		Loan: model.Loan{
+			ID:                 loanID,
+			Principal:          currency.NewRupiah(1000000, 0),
+			StartDate:          time.Now().UTC().Truncate(time.Microsecond),
+			OutstandingBalance: currency.NewRupiah(1000000, 0),
+		},
+		Frequency:         model.FrequencyWeekly,
+		LoanTerm:          10,
+		InstallmentAmount: currency.NewRupiah(100000, 0),
+	}
+
+	errRollback := errors.New("rollback")
+	err = storage.WithinTx(func(tx ports.LoanStorage) error {
+		g.Expect(tx.CreateLoan(weeklyLoan)).To(Succeed())
+
+		_, err := tx.GetLoan(loanID)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return errRollback
+	})
+	g.Expect(err).To(Equal(errRollback))
+
+	_, err = storage.GetLoan(loanID)
+	g.Expect(err).To(Equal(model.ErrLoanNotFound))
+
+	err = storage.WithinTx(func(tx ports.LoanStorage) error {
+		return tx.CreateLoan(weeklyLoan)
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = storage.GetLoan(loanID)
+	g.Expect(err).ToNot(HaveOccurred())
+}
+
+func TestLoanServiceWithSQLStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	storage := sqlstorage.NewLoanSQLStorage(openTestDB(t))
+	loanService := loan.NewLoanService(storage)
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: currency.NewRupiah(5000000, 0), AnnualInterestRate: model.BPS(1000), LoanTerm: 50, RateBasis: model.RatePerTenor})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	err = loanService.RecordPayment(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 8), createdLoan.InstallmentAmount.Multiply(2))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(5500000-(110000*2), 0)))
+	g.Expect(updatedLoan.Payments).To(HaveLen(1))
+
+	isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 15))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeFalse())
+}
@@ -8,5 +8,25 @@ type ServiceConfig struct {
 	Port        int    `env:"PORT" envDefault:"8080" envDocs:"The port which the service will listen to"`
 	GRPCPort    int    `env:"GRPC_PORT" envDefault:"8081" envDocs:"The gRPC port which the service will listen to"`
 
-	MissedPaymentThreshold int `env:"MISSED_PAYMENT_THRESHOLD" envDefault:"1" envDocs:"Missing Repayment Threshold to be flagged as delinquent account"`
+	StorageDriver string `env:"STORAGE_DRIVER" envDefault:"memory" envDocs:"Storage backend for the loan data (valid: [memory, postgres])"`
+	DatabaseURL   string `env:"DATABASE_URL" envDocs:"PostgreSQL connection string, required when STORAGE_DRIVER is postgres"`
+
+	InterestRebateRule string `env:"INTEREST_REBATE_RULE" envDefault:"pro_rata" envDocs:"Unearned interest rebate on early settlement (valid: [none, pro_rata, rule_of_78])"`
+	RoundingMode       string `env:"ROUNDING_MODE" envDefault:"half_even" envDocs:"How the computed amounts are rounded to the sen (valid: [half_even, half_up, floor])"`
+
+	LoanBackdateDays    int `env:"LOAN_BACKDATE_DAYS" envDefault:"7" envDocs:"Days before now a new loan may have started (been disbursed)"`
+	LoanForwardDateDays int `env:"LOAN_FORWARD_DATE_DAYS" envDefault:"0" envDocs:"Days after now a new loan may start"`
+
+	MissedPaymentThreshold        int            `env:"MISSED_PAYMENT_THRESHOLD" envDefault:"1" envDocs:"Missing Repayment Threshold to be flagged as delinquent account"`
+	DelinquencyGraceDays          int            `env:"DELINQUENCY_GRACE_DAYS" envDefault:"0" envDocs:"Days after the due date before a billing counts as missed"`
+	ProductMissedPaymentThreshold map[string]int `env:"PRODUCT_MISSED_PAYMENT_THRESHOLD" envDocs:"MISSED_PAYMENT_THRESHOLD overridden per loan product (e.g. paylater:0,cash:2)"`
+	ProductDelinquencyGraceDays   map[string]int `env:"PRODUCT_DELINQUENCY_GRACE_DAYS" envDocs:"DELINQUENCY_GRACE_DAYS overridden per loan product (e.g. paylater:3)"`
+
+	LateFeeGraceDays    int `env:"LATE_FEE_GRACE_DAYS" envDefault:"0" envDocs:"Days after the due date before a billing is charged late"`
+	LateFeeFixed        int `env:"LATE_FEE_FIXED" envDefault:"0" envDocs:"Late fee in rupiah charged once per missed billing"`
+	LateFeeOverdueBps   int `env:"LATE_FEE_OVERDUE_BPS" envDefault:"0" envDocs:"Late fee in basis point of the overdue amount, charged once per missed billing"`
+	LatePenaltyDailyBps int `env:"LATE_PENALTY_DAILY_BPS" envDefault:"0" envDocs:"Penalty interest in basis point of the overdue amount, charged every day"`
+	LateFeeCap          int `env:"LATE_FEE_CAP" envDefault:"0" envDocs:"Max late fee + penalty in rupiah of a single billing, 0 is no cap"`
+
+	EndOfDayRunAt string `env:"END_OF_DAY_RUN_AT" envDefault:"00:30" envDocs:"UTC time of the day (HH:MM) the end-of-day batch sweeps the previous business date, empty disables it"`
 }
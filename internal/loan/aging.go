@@ -0,0 +1,81 @@
+package loan
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// AgeLoan tells the days past due and the buckets of the loan at `asOf`, they are kept on the delinquency status for
+// the portfolio reports. An aging in the future or before the latest one kept is only told, not kept
+func (ls *LoanService) AgeLoan(loanID model.LoanID, asOf time.Time) (model.Aging, error) {
+	asOf = asOf.UTC()
+
+	var aging model.Aging
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		aging, err = ageLoan(tx, loanID, asOf, ls.clock.Now())
+
+		return err
+	})
+	if err != nil {
+		return model.Aging{}, err
+	}
+
+	return aging, nil
+}
+
+func ageLoan(tx ports.LoanStorage, loanID model.LoanID, asOf time.Time, now time.Time) (model.Aging, error) {
+	loan, err := tx.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return model.Aging{}, err
+	}
+
+	pastDue, err := tx.GetUnfulfilledBillingUntil(loanID, asOf)
+	if err != nil {
+		return model.Aging{}, err
+	}
+
+	// credit held from overpayment is drawn by the billings as they are due
+	allocate(pastDue, loan.Credit, &model.Payment{}, func(model.Billing) bool { return true })
+
+	aging := model.Aging{
+		LoanID:        loanID,
+		AsOf:          asOf,
+		OverdueAmount: currency.NewRupiah(0, 0),
+	}
+
+	for _, b := range pastDue {
+		// not late on the due date itself
+		if b.IsPaid() || !b.PaymentDueDate.Before(asOf) {
+			continue
+		}
+
+		if aging.OverdueAmount == 0 {
+			aging.DaysPastDue = model.DaysBetween(b.PaymentDueDate, asOf)
+		}
+		aging.OverdueAmount = aging.OverdueAmount.Add(b.Remaining())
+	}
+
+	aging.Bucket = model.BucketOf(aging.DaysPastDue)
+	aging.Kolektibilitas = model.KolektibilitasOf(aging.DaysPastDue)
+
+	if asOf.After(now) || asOf.Before(loan.AgedAt) {
+		return aging, nil
+	}
+
+	delinquencyUpdateParams := loan.DelinquencyStatus
+	delinquencyUpdateParams.DaysPastDue = aging.DaysPastDue
+	delinquencyUpdateParams.Bucket = aging.Bucket
+	delinquencyUpdateParams.Kolektibilitas = aging.Kolektibilitas
+	delinquencyUpdateParams.AgedAt = asOf
+
+	err = tx.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
+	if err != nil {
+		return model.Aging{}, err
+	}
+
+	return aging, nil
+}
@@ -0,0 +1,164 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+	"go.jetify.com/typeid"
+)
+
+func TestAgeLoan(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+		AllocationPolicy:   model.AllocationExactDue,
+	}
+
+	t.Run("Days Past Due From the Oldest Unpaid Billing", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(45)
+
+		aging, err := loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 7))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(BeZero(), "not late on the due date")
+		g.Expect(aging.Bucket).To(Equal(model.BucketCurrent))
+		g.Expect(aging.Kolektibilitas).To(Equal(model.KolektibilitasLancar))
+		g.Expect(aging.OverdueAmount).To(BeZero())
+
+		aging, err = loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(Equal(3))
+		g.Expect(aging.Bucket).To(Equal(model.Bucket1To30))
+		g.Expect(aging.Kolektibilitas).To(Equal(model.KolektibilitasDalamPerhatianKhusus))
+		g.Expect(aging.OverdueAmount).To(Equal(currency.NewRupiah(110000, 0)))
+
+		// the first billing was due on day 7, the sixth on day 42
+		aging, err = loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 45))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(Equal(38))
+		g.Expect(aging.Bucket).To(Equal(model.Bucket31To60))
+		g.Expect(aging.OverdueAmount).To(Equal(currency.NewRupiah(660000, 0)))
+
+		agedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(agedLoan.DaysPastDue).To(Equal(38))
+		g.Expect(agedLoan.Bucket).To(Equal(model.Bucket31To60))
+		g.Expect(agedLoan.Kolektibilitas).To(Equal(model.KolektibilitasDalamPerhatianKhusus))
+		g.Expect(agedLoan.AgedAt).To(Equal(createdLoan.StartDate.AddDate(0, 0, 45)))
+	})
+
+	t.Run("Past and Future Are Not Kept", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(40)
+		aging, err := loanService.AgeLoan(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(Equal(33))
+
+		aging, err = loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.Bucket).To(Equal(model.BucketCurrent))
+
+		aging, err = loanService.AgeLoan(createdLoan.ID, clock.Now().AddDate(1, 0, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.Bucket).To(Equal(model.Bucket90Plus))
+
+		agedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(agedLoan.DaysPastDue).To(Equal(33))
+		g.Expect(agedLoan.Bucket).To(Equal(model.Bucket31To60))
+		g.Expect(agedLoan.AgedAt).To(Equal(clock.Now()))
+	})
+
+	t.Run("Backdated Loan Kept From Its Start", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		backdated := param
+		backdated.StartDate = clock.Now().AddDate(0, 0, -5)
+		createdLoan, err := loanService.CreateLoan(backdated)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		agedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(agedLoan.AgedAt).To(Equal(backdated.StartDate))
+
+		// before now but after the start, kept
+		_, err = loanService.AgeLoan(createdLoan.ID, clock.Now().AddDate(0, 0, -2))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		agedLoan, err = loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(agedLoan.AgedAt).To(Equal(clock.Now().AddDate(0, 0, -2)))
+	})
+
+	t.Run("Current Again Once Paid", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		aging, err := loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.Bucket).To(Equal(model.Bucket1To30))
+
+		// the first two installments are due by then
+		err = loanService.RecordPayment(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10), currency.NewRupiah(220000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		aging, err = loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(BeZero())
+		g.Expect(aging.Bucket).To(Equal(model.BucketCurrent))
+	})
+
+	t.Run("Credit Draws the Due Billings", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		flexible := param
+		flexible.AllocationPolicy = model.AllocationHoldAsCredit
+
+		createdLoan, err := loanService.CreateLoan(flexible)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// pays ahead the first billing and a half of the second
+		err = loanService.RecordPayment(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 1), currency.NewRupiah(165000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		aging, err := loanService.AgeLoan(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 20))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(aging.DaysPastDue).To(Equal(6), "since the second billing")
+		g.Expect(aging.OverdueAmount).To(Equal(currency.NewRupiah(55000, 0)))
+	})
+
+	t.Run("Unknown Loan", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		randoID, err := typeid.New[model.LoanID]()
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = loanService.AgeLoan(randoID, time.Now())
+		g.Expect(err).To(Equal(model.ErrLoanNotFound))
+	})
+}
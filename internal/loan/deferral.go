@@ -0,0 +1,222 @@
+package loan
+
+import (
+	"slices"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// DeferInstallments gives the loan a payment holiday of `param.Periods` installments from the first one due after
+// `param.When` and not paid yet. The deferred installments only keep what has been paid of them and are never missed,
+// what was left to pay of them is due later: every installment from the first deferred one moves `param.Periods`
+// installments later and the tenor grows (`model.DeferralExtendTenor`), or it is spread over the installments after
+// the holiday (`model.DeferralSpread`). Nothing more is charged for the holiday
+func (ls *LoanService) DeferInstallments(loanID model.LoanID, param model.DeferralParam) (model.DeferralEvent, error) {
+	now := ls.clock.Now().UTC()
+
+	when := param.When.UTC()
+	if param.When.IsZero() {
+		when = now
+	}
+
+	method := param.Method
+	if method == "" {
+		method = model.DeferralExtendTenor
+	}
+
+	// validation, tiger style
+	if !(param.Periods > 0) {
+		return model.DeferralEvent{}, model.ErrNoDeferralPeriod
+	}
+
+	if !method.IsValid() {
+		return model.DeferralEvent{}, model.ErrUnknownDeferralMethod
+	}
+
+	var event model.DeferralEvent
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		loan, err := tx.GetLoanWithDelinquency(loanID)
+		if err != nil {
+			return err
+		}
+
+		if loan.IsCompleted {
+			return model.ErrRepaymentComplete
+		}
+
+		if loan.IsWrittenOff() {
+			return model.ErrLoanWrittenOff
+		}
+
+		if when.Before(loan.StartDate) || when.After(now) {
+			return model.ErrInvalidDeferralDate
+		}
+
+		billings, err := tx.GetBillings(loanID)
+		if err != nil {
+			return err
+		}
+
+		first := slices.IndexFunc(billings, func(b model.Billing) bool {
+			return b.PaymentDueDate.After(when) && !b.IsPaid()
+		})
+		if first < 0 {
+			return model.ErrNothingToDefer
+		}
+
+		upcoming := billings[first:]
+		if method == model.DeferralSpread && !(len(upcoming) > param.Periods) {
+			return model.ErrNothingToDefer
+		}
+
+		deferredAmount := currency.NewRupiah(0, 0)
+		for _, b := range upcoming[:min(param.Periods, len(upcoming))] {
+			deferredAmount = deferredAmount.Add(b.Remaining())
+		}
+
+		switch method {
+		case model.DeferralExtendTenor:
+			loanUpdateParams := loan.InstallmentLoan
+			loanUpdateParams.LoanTerm += param.Periods
+
+			err = tx.UpdateLoan(loanID, loanUpdateParams)
+			if err != nil {
+				return err
+			}
+
+			err = tx.CreateBillings(loanID, extendTenor(loanUpdateParams, upcoming, param.Periods, when))
+			if err != nil {
+				return err
+			}
+		case model.DeferralSpread:
+			spreadDeferred(upcoming, param.Periods, when, ls.rounding)
+		}
+
+		err = tx.UpdateBillings(loanID, upcoming)
+		if err != nil {
+			return err
+		}
+
+		event = model.DeferralEvent{
+			LoanID:         loanID,
+			At:             when,
+			FromTerm:       upcoming[0].TermNumber,
+			Periods:        param.Periods,
+			Method:         method,
+			DeferredAmount: deferredAmount,
+			Reason:         param.Reason,
+		}
+
+		err = tx.RecordDeferralEvent(loanID, event)
+		if err != nil {
+			return err
+		}
+
+		// a holiday backdated over missed installments cures the loan
+		_, err = checkDelinquency(tx, loanID, now, now)
+
+		return err
+	})
+	if err != nil {
+		return model.DeferralEvent{}, err
+	}
+
+	return event, nil
+}
+
+// GetDeferralHistory lists the payment holidays given to the loan, oldest first
+func (ls *LoanService) GetDeferralHistory(loanID model.LoanID) ([]model.DeferralEvent, error) {
+	return ls.storage.GetDeferralEvents(loanID)
+}
+
+// extendTenor moves what is left to pay of every upcoming installment `periods` installments later, `loan` already
+// has its tenor extended. The first `periods` installments are deferred at `at`. `upcoming` is updated in place and
+// the installments appended to the schedule are returned
+func extendTenor(loan model.InstallmentLoan, upcoming []model.Billing, periods int, at time.Time) []model.Billing {
+	appended := make([]model.Billing, periods)
+	for i := range appended {
+		term := loan.LoanTerm - periods + 1 + i
+		appended[i] = model.Billing{
+			LoanID:         loan.ID,
+			TermsVersion:   loan.TermsVersion,
+			TermNumber:     term,
+			PaymentDueDate: loan.DueDate(term),
+		}
+	}
+
+	slots := slices.Concat(upcoming, appended)
+
+	left := make([]model.Billing, len(upcoming))
+	for i := range upcoming {
+		left[i] = strip(&slots[i])
+	}
+
+	for i, l := range left {
+		owe(&slots[i+periods], l)
+	}
+
+	for i := range periods {
+		slots[i].DeferredAt = at
+	}
+
+	copy(upcoming, slots)
+
+	return slots[len(upcoming):]
+}
+
+// spreadDeferred defers the first `periods` upcoming installments at `at` and spreads what was left to pay of them
+// over the installments after, component by component. `upcoming` is updated in place
+func spreadDeferred(upcoming []model.Billing, periods int, at time.Time, rounding model.RoundingMode) {
+	var deferred model.Billing
+	for i := range periods {
+		owe(&deferred, strip(&upcoming[i]))
+		upcoming[i].DeferredAt = at
+	}
+
+	rest := upcoming[periods:]
+	principal := spread(deferred.Principal, len(rest))
+	interest := spread(deferred.Interest, len(rest))
+	fee := spread(deferred.Fee, len(rest))
+	penalty := spread(deferred.Penalty, len(rest))
+
+	for i := range rest {
+		owe(&rest[i], model.Billing{
+			Repayment: principal[i].Add(interest[i]).Add(fee[i]).Add(penalty[i]),
+			Principal: principal[i],
+			Interest:  interest[i],
+			Fee:       fee[i],
+			Penalty:   penalty[i],
+		})
+	}
+}
+
+// strip leaves only what has been paid of the billing and returns what was left to pay of it, component by component
+func strip(b *model.Billing) model.Billing {
+	left := model.Billing{
+		Repayment: b.Repayment.Subtract(b.PaidAmount),
+		Principal: b.Principal.Subtract(b.PaidPrincipal),
+		Interest:  b.Interest.Subtract(b.PaidInterest),
+		Fee:       b.Fee.Subtract(b.PaidFee),
+		Penalty:   b.Penalty.Subtract(b.PaidPenalty),
+	}
+
+	b.Repayment = b.PaidAmount
+	b.Principal = b.PaidPrincipal
+	b.Interest = b.PaidInterest
+	b.Fee = b.PaidFee
+	b.Penalty = b.PaidPenalty
+
+	return left
+}
+
+// owe adds what is due in `amount` to the billing, component by component
+func owe(b *model.Billing, amount model.Billing) {
+	b.Repayment = b.Repayment.Add(amount.Repayment)
+	b.Principal = b.Principal.Add(amount.Principal)
+	b.Interest = b.Interest.Add(amount.Interest)
+	b.Fee = b.Fee.Add(amount.Fee)
+	b.Penalty = b.Penalty.Add(amount.Penalty)
+}
@@ -0,0 +1,182 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+func TestDeferInstallments(t *testing.T) {
+	t.Parallel()
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	}
+
+	setup := func(g *WithT) (*loan.LoanService, *clocktest.Clock, model.InstallmentLoan) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return loanService, clock, createdLoan
+	}
+
+	t.Run("Extends the Tenor", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g)
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(110000, 0))).To(Succeed())
+
+		clock.AdvanceDays(4)
+		event, err := loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{Periods: 2, Reason: "flood relief"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(event).To(Equal(model.DeferralEvent{
+			LoanID:         createdLoan.ID,
+			At:             clock.Now(),
+			FromTerm:       2,
+			Periods:        2,
+			Method:         model.DeferralExtendTenor,
+			DeferredAmount: currency.NewRupiah(220000, 0),
+			Reason:         "flood relief",
+		}))
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.LoanTerm).To(Equal(12))
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(990000, 0)), "nothing more is charged")
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings).To(HaveLen(12))
+		for _, b := range billings[1:3] {
+			g.Expect(b.Repayment).To(BeZero())
+			g.Expect(b.DeferredAt).To(Equal(clock.Now()))
+		}
+		for _, b := range billings[3:] {
+			g.Expect(b.Repayment).To(Equal(currency.NewRupiah(110000, 0)))
+			g.Expect(b.DeferredAt).To(BeZero())
+		}
+		g.Expect(billings[11].PaymentDueDate).To(Equal(createdLoan.StartDate.AddDate(0, 0, 84)))
+
+		// only the fourth installment is missed at day 30
+		clock.AdvanceDays(26)
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse())
+
+		history, err := loanService.GetDeferralHistory(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(Equal([]model.DeferralEvent{event}))
+	})
+
+	t.Run("Spreads the Deferred Installments", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g)
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(110000, 0))).To(Succeed())
+
+		clock.AdvanceDays(4)
+		_, err := loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{Periods: 2, Method: model.DeferralSpread})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.LoanTerm).To(Equal(10))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings).To(HaveLen(10))
+		g.Expect(billings[1].Repayment).To(BeZero())
+		g.Expect(billings[2].Repayment).To(BeZero())
+
+		// 220000 over the 7 installments left
+		total := currency.NewRupiah(0, 0)
+		for _, b := range billings[3:] {
+			g.Expect(b.Repayment).To(BeNumerically("~", currency.NewRupiah(110000+31428, 57), 1))
+			g.Expect(b.Repayment).To(Equal(b.Principal.Add(b.Interest)))
+			total = total.Add(b.Repayment)
+		}
+		g.Expect(total).To(Equal(currency.NewRupiah(990000, 0)))
+	})
+
+	t.Run("Keeps What Has Been Paid", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g)
+
+		// the second installment is half paid in advance
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(165000, 0))).To(Succeed())
+
+		clock.AdvanceDays(8)
+		event, err := loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{Periods: 1})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(event.FromTerm).To(Equal(2))
+		g.Expect(event.DeferredAmount).To(Equal(currency.NewRupiah(55000, 0)))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[1].Repayment).To(Equal(currency.NewRupiah(55000, 0)))
+		g.Expect(billings[1].IsPaid()).To(BeTrue())
+		g.Expect(billings[2].Repayment).To(Equal(currency.NewRupiah(55000, 0)))
+		g.Expect(billings[2].Interest).To(BeZero(), "paid first")
+		g.Expect(billings[3].Repayment).To(Equal(currency.NewRupiah(110000, 0)))
+	})
+
+	t.Run("Cures a Backdated Holiday", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g)
+
+		clock.AdvanceDays(22)
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		// the disaster struck before the first installment
+		_, err = loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{When: createdLoan.StartDate.AddDate(0, 0, 6), Periods: 3})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+		g.Expect(updatedLoan.CuredAt).To(Equal(clock.Now()))
+		g.Expect(updatedLoan.LoanTerm).To(Equal(13))
+
+		isDelinquent, _, err = loanService.ColdDelinquentFlag(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g)
+
+		deferInstallments := func(param model.DeferralParam) error {
+			_, err := loanService.DeferInstallments(createdLoan.ID, param)
+			return err
+		}
+
+		g.Expect(deferInstallments(model.DeferralParam{})).To(MatchError(model.ErrNoDeferralPeriod))
+		g.Expect(deferInstallments(model.DeferralParam{Periods: 1, Method: "skip"})).To(MatchError(model.ErrUnknownDeferralMethod))
+		g.Expect(deferInstallments(model.DeferralParam{Periods: 1, When: createdLoan.StartDate.Add(-time.Second)})).To(MatchError(model.ErrInvalidDeferralDate))
+		g.Expect(deferInstallments(model.DeferralParam{Periods: 1, When: clock.Now().Add(time.Second)})).To(MatchError(model.ErrInvalidDeferralDate))
+		g.Expect(deferInstallments(model.DeferralParam{Periods: 10, Method: model.DeferralSpread})).To(MatchError(model.ErrNothingToDefer))
+
+		history, err := loanService.GetDeferralHistory(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(BeEmpty())
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(1100000, 0))).To(Succeed())
+		g.Expect(deferInstallments(model.DeferralParam{Periods: 1})).To(MatchError(model.ErrRepaymentComplete))
+	})
+}
@@ -4,21 +4,136 @@ import (
 	"time"
 
 	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
 )
 
 // ColdDelinquentFlag do a search through db to check the account delinquency
 func (ls *LoanService) ColdDelinquentFlag(loanID model.LoanID, checkAt time.Time) (bool, []model.Billing, error) {
-	// I assume the account is delinquent after missing payment 2 times,
-	// and no repayment have been made before the week #2 due date
+	return coldDelinquentFlag(ls.storage, loanID, checkAt)
+}
+
+// GetDelinquencyHistory lists every time the loan became delinquent or got cured, oldest first
+func (ls *LoanService) GetDelinquencyHistory(loanID model.LoanID) ([]model.DelinquencyEvent, error) {
+	return ls.storage.GetDelinquencyEvents(loanID)
+}
+
+// checkDelinquency evaluates the delinquency at `when` and stores the transition, unless `when` is after `now`. The
+// delinquency of a written-off loan is not evaluated anymore, it stays as it was at the write-off
+func checkDelinquency(tx ports.LoanStorage, loanID model.LoanID, when time.Time, now time.Time) (bool, error) {
+	loan, err := tx.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return false, err
+	}
+
+	if loan.IsWrittenOff() {
+		return loan.IsDelinquent, nil
+	}
+
+	isDelinquent, unfulfilledBilling, err := coldDelinquentFlag(tx, loanID, when)
+	if err != nil {
+		return false, err
+	}
+
+	if loan.IsCompleted || when.After(now) {
+		return isDelinquent, nil
+	}
+
+	delinquencyUpdateParams := loan.DelinquencyStatus
+
+	err = transitionDelinquency(tx, loanID, &delinquencyUpdateParams, isDelinquent, missedBillings(loan.DelinquencyRule, unfulfilledBilling, when), when)
+	if err != nil {
+		return false, err
+	}
+
+	if delinquencyUpdateParams == loan.DelinquencyStatus {
+		return isDelinquent, nil
+	}
 
-	unfulfilledBilling, err := ls.storage.GetUnfulfilledBillingAt(loanID, checkAt.UTC())
+	err = tx.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
+	if err != nil {
+		return false, err
+	}
+
+	return isDelinquent, nil
+}
+
+type delinquencyStorage interface {
+	ports.LoanGetter
+	ports.BillingGetter
+}
+
+func coldDelinquentFlag(storage delinquencyStorage, loanID model.LoanID, checkAt time.Time) (bool, []model.Billing, error) {
+	// the account is delinquent after missing more payments than the threshold of the loan (2 times by default), a
+	// billing is only missed once the grace days after its due date are over
+
+	loan, err := storage.GetLoan(loanID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	checkAt = checkAt.UTC()
+
+	// every billing up to the running term is due
+	dueUntil := loan.DueDate(loan.CurrentTerm(checkAt))
+
+	unfulfilledBilling, err := storage.GetUnfulfilledBillingUntil(loanID, dueUntil)
 	if err != nil {
 		return false, nil, err
 	}
 
-	if len(unfulfilledBilling) > model.MISSED_PAYMENT_THRESHOLD+1 {
+	// credit held from overpayment is drawn by the billings as they are due
+	if loan.Credit > 0 {
+		allocate(unfulfilledBilling, loan.Credit, &model.Payment{}, func(model.Billing) bool { return true })
+
+		remaining := []model.Billing{}
+		for _, b := range unfulfilledBilling {
+			if !b.IsPaid() {
+				remaining = append(remaining, b)
+			}
+		}
+		unfulfilledBilling = remaining
+	}
+
+	if missedBillings(loan.DelinquencyRule, unfulfilledBilling, checkAt) > loan.DelinquencyRule.MissedPaymentThreshold {
 		return true, unfulfilledBilling, nil
 	}
 
 	return false, unfulfilledBilling, nil
 }
+
+// missedBillings counts the unpaid billings whose grace days are over at `checkAt`, a deferred billing is never missed
+func missedBillings(rule model.DelinquencyRule, billings []model.Billing, checkAt time.Time) int {
+	missed := 0
+	for _, b := range billings {
+		if !b.IsPaid() && b.DeferredAt.IsZero() && b.PaymentDueDate.AddDate(0, 0, rule.GraceDays).Before(checkAt) {
+			missed++
+		}
+	}
+
+	return missed
+}
+
+// transitionDelinquency flips the stored delinquency flag in `status` and records the event, nothing happens when the
+// flag is already `isDelinquent` or `at` is before the latest transition. The caller stores `status`
+func transitionDelinquency(tx ports.LoanStorage, loanID model.LoanID, status *model.DelinquencyStatus, isDelinquent bool, missed int, at time.Time) error {
+	if status.IsDelinquent == isDelinquent || at.Before(status.DelinquentAt) || at.Before(status.CuredAt) {
+		return nil
+	}
+
+	kind := model.DelinquencyEventCured
+	if isDelinquent {
+		kind = model.DelinquencyEventDelinquent
+		status.DelinquentAt = at
+	} else {
+		status.CuredAt = at
+	}
+
+	status.IsDelinquent = isDelinquent
+
+	return tx.RecordDelinquencyEvent(loanID, model.DelinquencyEvent{
+		LoanID:         loanID,
+		Kind:           kind,
+		At:             at,
+		MissedBillings: missed,
+	})
+}
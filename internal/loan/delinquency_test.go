@@ -0,0 +1,318 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+	"go.jetify.com/typeid"
+)
+
+func TestDelinquencyPolicy(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// an installment is due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	}
+
+	strict := model.DelinquencyPolicy{
+		DelinquencyRule: model.DelinquencyRule{MissedPaymentThreshold: 0},
+	}
+
+	t.Run("Snapshotted at Creation", func(t *testing.T) {
+		memStorage := memorystorage.NewLoanMemoryStorage()
+
+		strictLoan, err := loan.NewLoanService(memStorage, loan.WithDelinquencyPolicy(strict)).CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(strictLoan.DelinquencyRule).To(Equal(strict.DelinquencyRule))
+
+		// the policy changed afterwards
+		loanService := loan.NewLoanService(memStorage)
+
+		defaultLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(defaultLoan.DelinquencyRule.MissedPaymentThreshold).To(Equal(model.MISSED_PAYMENT_THRESHOLD))
+
+		// the first billing is missed on day 8
+		isDelinquent, err := loanService.CheckDelinquency(strictLoan.ID, strictLoan.StartDate.AddDate(0, 0, 8))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue(), "keeps the rule it was created with")
+
+		isDelinquent, err = loanService.CheckDelinquency(defaultLoan.ID, defaultLoan.StartDate.AddDate(0, 0, 8))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse())
+	})
+
+	t.Run("Grace Days", func(t *testing.T) {
+		lenient := strict
+		lenient.GraceDays = 3
+
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithDelinquencyPolicy(lenient))
+
+		exactDue := param
+		exactDue.AllocationPolicy = model.AllocationExactDue
+
+		createdLoan, err := loanService.CreateLoan(exactDue)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse(), "still within the grace days")
+
+		isDelinquent, err = loanService.CheckDelinquency(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 11))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		// the grace days only delay the flag, the missed billing and the running term are due on day 9
+		err = loanService.RecordPayment(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 9), currency.NewRupiah(220000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+	})
+
+	t.Run("Product Override", func(t *testing.T) {
+		policy := model.DefaultDelinquencyPolicy()
+		policy.Products = map[string]model.DelinquencyRule{
+			"paylater": {MissedPaymentThreshold: 0},
+		}
+
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithDelinquencyPolicy(policy))
+
+		paylater := param
+		paylater.Product = "paylater"
+
+		paylaterLoan, err := loanService.CreateLoan(paylater)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(paylaterLoan.Product).To(Equal("paylater"))
+
+		otherLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		isDelinquent, err := loanService.CheckDelinquency(paylaterLoan.ID, paylaterLoan.StartDate.AddDate(0, 0, 8))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		isDelinquent, err = loanService.CheckDelinquency(otherLoan.ID, otherLoan.StartDate.AddDate(0, 0, 8))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse())
+	})
+}
+
+// eventRecordingStorage keeps the delinquency events of every committed unit of work
+type eventRecordingStorage struct {
+	*memorystorage.LoanStorage
+	events *[]model.DelinquencyEvent
+}
+
+func (s eventRecordingStorage) WithinTx(fn func(tx ports.LoanStorage) error) error {
+	var events []model.DelinquencyEvent
+
+	err := s.LoanStorage.WithinTx(func(tx ports.LoanStorage) error {
+		return fn(eventRecordingTx{tx, &events})
+	})
+	if err != nil {
+		return err
+	}
+
+	*s.events = append(*s.events, events...)
+
+	return nil
+}
+
+type eventRecordingTx struct {
+	ports.LoanStorage
+	events *[]model.DelinquencyEvent
+}
+
+func (tx eventRecordingTx) RecordDelinquencyEvent(loanID model.LoanID, event model.DelinquencyEvent) error {
+	*tx.events = append(*tx.events, event)
+
+	return tx.LoanStorage.RecordDelinquencyEvent(loanID, event)
+}
+
+func TestDelinquencyCure(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// every installment is 110000 due every 7 days, 2 missed billings flag the loan
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+		AllocationPolicy:   model.AllocationExactDue,
+	}
+
+	t.Run("Cured by Paying the Arrears", func(t *testing.T) {
+		var events []model.DelinquencyEvent
+		loanService := loan.NewLoanService(eventRecordingStorage{memorystorage.NewLoanMemoryStorage(), &events})
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		day := func(n int) time.Time { return createdLoan.StartDate.AddDate(0, 0, n) }
+
+		// the first 2 billings are missed by day 15
+		err = loanService.RecordPayment(createdLoan.ID, day(15), currency.NewRupiah(110000*3+1, 0))
+		g.Expect(err).To(Equal(model.ErrMismatchPayment), "no more than the due billings")
+
+		err = loanService.RecordPayment(createdLoan.ID, day(15), currency.NewRupiah(50000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeTrue(), "still 2 billings missed")
+
+		// the oldest billing is paid, only 1 is missed
+		err = loanService.RecordPayment(createdLoan.ID, day(16), currency.NewRupiah(60000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err = loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+
+		g.Expect(events).To(Equal([]model.DelinquencyEvent{
+			{LoanID: createdLoan.ID, Kind: model.DelinquencyEventDelinquent, At: day(15), MissedBillings: 2},
+			{LoanID: createdLoan.ID, Kind: model.DelinquencyEventCured, At: day(16), MissedBillings: 1},
+		}))
+
+		// back to the exact due billings
+		err = loanService.RecordPayment(createdLoan.ID, day(16), currency.NewRupiah(50000, 0))
+		g.Expect(err).To(Equal(model.ErrMismatchPayment))
+	})
+
+	t.Run("Cured by Settlement", func(t *testing.T) {
+		var events []model.DelinquencyEvent
+		loanService := loan.NewLoanService(eventRecordingStorage{memorystorage.NewLoanMemoryStorage(), &events})
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		settleAt := createdLoan.StartDate.AddDate(0, 0, 22)
+
+		err = loanService.RecordPayment(createdLoan.ID, settleAt, currency.NewRupiah(10000, 0))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		quote, err := loanService.QuotePayoff(createdLoan.ID, settleAt)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = loanService.SettleLoan(createdLoan.ID, settleAt, quote.PayoffAmount)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+		g.Expect(events).To(HaveLen(2))
+		g.Expect(events[1].Kind).To(Equal(model.DelinquencyEventCured))
+		g.Expect(events[1].MissedBillings).To(BeZero())
+	})
+}
+
+// startedLoan stores a weekly loan started `daysAgo` without going through `CreateLoan`, so its billings can be due
+// already. Every installment is 100000 principal + 10000 interest
+func startedLoan(g *WithT, storage *memorystorage.LoanStorage, daysAgo int) model.InstallmentLoan {
+	loanID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	startedLoan := model.InstallmentLoan{
+		Loan: model.Loan{
+			ID:                 loanID,
+			Principal:          currency.NewRupiah(1000000, 0),
+			AnnualInterestRate: model.BPS(1000),
+			StartDate:          time.Now().UTC().AddDate(0, 0, -daysAgo),
+			TotalInterest:      currency.NewRupiah(100000, 0),
+			OutstandingBalance: currency.NewRupiah(1100000, 0),
+			AllocationPolicy:   model.AllocationApplyToFuture,
+			InterestMethod:     model.InterestFlat,
+			RateBasis:          model.RatePerTenor,
+			DayCount:           model.DayCountAct365,
+			DelinquencyRule:    model.DefaultDelinquencyPolicy().DelinquencyRule,
+		},
+		Frequency:           model.FrequencyWeekly,
+		LoanTerm:            10,
+		InstallmentAmount:   currency.NewRupiah(110000, 0),
+		InstallmentInterest: currency.NewRupiah(10000, 0),
+	}
+
+	billings := []model.Billing{}
+	for term := 1; term <= startedLoan.LoanTerm; term++ {
+		billings = append(billings, model.Billing{
+			TermNumber:     term,
+			PaymentDueDate: startedLoan.DueDate(term),
+			Repayment:      currency.NewRupiah(110000, 0),
+			Principal:      currency.NewRupiah(100000, 0),
+			Interest:       currency.NewRupiah(10000, 0),
+		})
+	}
+
+	g.Expect(storage.CreateLoan(startedLoan)).To(Succeed())
+	g.Expect(storage.CreateDelinquencyStatus(loanID, model.DelinquencyStatus{LoanID: loanID})).To(Succeed())
+	g.Expect(storage.CreateBillings(loanID, billings)).To(Succeed())
+
+	return startedLoan
+}
+
+func TestDelinquencyHistory(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	memStorage := memorystorage.NewLoanMemoryStorage()
+	loanService := loan.NewLoanService(memStorage)
+
+	// due 15, 8 and 1 day ago
+	startedLoan := startedLoan(g, memStorage, 22)
+	now := time.Now().UTC()
+
+	isDelinquent, err := loanService.CheckDelinquency(startedLoan.ID, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeTrue())
+
+	isDelinquent, err = loanService.CheckDelinquency(startedLoan.ID, now.Add(time.Minute))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeTrue(), "recorded once")
+
+	// a missed billing only, before the loan became delinquent
+	isDelinquent, err = loanService.CheckDelinquency(startedLoan.ID, startedLoan.StartDate.AddDate(0, 0, 10))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeFalse(), "the past doesn't flip the latest transition")
+
+	flagged, err := loanService.GetLoan(startedLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(flagged.IsDelinquent).To(BeTrue())
+	g.Expect(flagged.DelinquentAt).To(Equal(now))
+
+	// only the first 2 missed billings are paid
+	err = loanService.RecordPayment(startedLoan.ID, now.Add(time.Hour), currency.NewRupiah(220000, 0))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	cured, err := loanService.GetLoan(startedLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(cured.IsDelinquent).To(BeFalse())
+	g.Expect(cured.CuredAt).To(Equal(now.Add(time.Hour)))
+
+	// projected in the future, nothing is stored
+	isDelinquent, err = loanService.CheckDelinquency(startedLoan.ID, now.AddDate(0, 0, 30))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeTrue())
+
+	events, err := loanService.GetDelinquencyHistory(startedLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(events).To(Equal([]model.DelinquencyEvent{
+		{LoanID: startedLoan.ID, Kind: model.DelinquencyEventDelinquent, At: now, MissedBillings: 3},
+		{LoanID: startedLoan.ID, Kind: model.DelinquencyEventCured, At: now.Add(time.Hour), MissedBillings: 1},
+	}))
+
+	randoID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = loanService.GetDelinquencyHistory(randoID)
+	g.Expect(err).To(Equal(model.ErrLoanNotFound))
+}
@@ -0,0 +1,168 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+)
+
+// endOfDayPageSize is how many active loans are listed at once by the end-of-day batch
+const endOfDayPageSize = 100
+
+// RunEndOfDay sweeps every active loan at the end of `businessDate`: the late charges are accrued, the delinquency is
+// evaluated and the loan is aged. Each loan is swept in its own transaction together with the checkpoint of the run,
+// so an interrupted run resumes after the last swept loan and a finished run is not repeated. A run racing another run
+// of the same business date stops with `model.ErrEndOfDayRunInProgress`. `emit` receives the result of every swept
+// loan
+func (ls *LoanService) RunEndOfDay(ctx context.Context, businessDate time.Time, emit func(model.EndOfDayResult)) (model.EndOfDayRun, error) {
+	businessDate = model.BusinessDate(businessDate)
+	asOf := businessDate.AddDate(0, 0, 1)
+
+	// the business date has to be over
+	if asOf.After(ls.clock.Now()) {
+		return model.EndOfDayRun{}, model.ErrBusinessDayNotOver
+	}
+
+	var run model.EndOfDayRun
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		run, err = tx.GetEndOfDayRun(model.EndOfDayRunKey(businessDate))
+		if !errors.Is(err, model.ErrEndOfDayRunNotFound) {
+			return err
+		}
+
+		run = model.EndOfDayRun{
+			RunKey:       model.EndOfDayRunKey(businessDate),
+			BusinessDate: businessDate,
+			StartedAt:    ls.clock.Now().UTC(),
+		}
+
+		return tx.CreateEndOfDayRun(run)
+	})
+	if err != nil {
+		return model.EndOfDayRun{}, err
+	}
+
+	if run.IsFinished() {
+		return run, nil
+	}
+
+	for {
+		loanIDs, err := ls.storage.ListActiveLoans(run.Checkpoint, endOfDayPageSize)
+		if err != nil {
+			return run, err
+		}
+
+		if len(loanIDs) == 0 {
+			break
+		}
+
+		for _, loanID := range loanIDs {
+			if err := ctx.Err(); err != nil {
+				return run, err
+			}
+
+			var (
+				result model.EndOfDayResult
+				next   model.EndOfDayRun
+			)
+			err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+				err := lockEndOfDayRun(tx, run)
+				if err != nil {
+					return err
+				}
+
+				result, err = ls.endOfDay(tx, loanID, asOf)
+				if err != nil {
+					return err
+				}
+
+				next = run
+				next.Checkpoint = loanID
+				next.Processed++
+				next.Charged = next.Charged.Add(result.Charged)
+				if result.IsDelinquent {
+					next.Delinquent++
+				}
+
+				return tx.UpdateEndOfDayRun(next)
+			})
+			if err != nil {
+				return run, err
+			}
+
+			run = next
+			result.BusinessDate = businessDate
+			emit(result)
+		}
+	}
+
+	run.FinishedAt = ls.clock.Now().UTC()
+
+	err = ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		err := lockEndOfDayRun(tx, run)
+		if err != nil {
+			return err
+		}
+
+		return tx.UpdateEndOfDayRun(run)
+	})
+	if err != nil {
+		return run, err
+	}
+
+	return run, nil
+}
+
+// lockEndOfDayRun locks the run before its checkpoint moves, a run of the same business date racing this one waits for
+// the lock and then finds the checkpoint moved already
+func lockEndOfDayRun(tx ports.LoanStorage, run model.EndOfDayRun) error {
+	locked, err := tx.GetEndOfDayRun(run.RunKey)
+	if err != nil {
+		return err
+	}
+
+	if locked.IsFinished() || locked.Checkpoint != run.Checkpoint || locked.Processed != run.Processed {
+		return model.ErrEndOfDayRunInProgress
+	}
+
+	return nil
+}
+
+// endOfDay sweeps a single loan at `asOf`, a loan aged after `asOf` already is not aged back
+func (ls *LoanService) endOfDay(tx ports.LoanStorage, loanID model.LoanID, asOf time.Time) (model.EndOfDayResult, error) {
+	charged, err := accrueLateCharges(tx, loanID, asOf, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+	if err != nil {
+		return model.EndOfDayResult{}, err
+	}
+
+	isDelinquent, err := checkDelinquency(tx, loanID, asOf, ls.clock.Now())
+	if err != nil {
+		return model.EndOfDayResult{}, err
+	}
+
+	result := model.EndOfDayResult{
+		LoanID:       loanID,
+		IsDelinquent: isDelinquent,
+		Charged:      charged,
+	}
+
+	status, err := tx.GetDelinquencyStatus(loanID)
+	if err != nil {
+		return model.EndOfDayResult{}, err
+	}
+
+	if status.AgedAt.After(asOf) {
+		return result, nil
+	}
+
+	result.Aging, err = ageLoan(tx, loanID, asOf, ls.clock.Now())
+	if err != nil {
+		return model.EndOfDayResult{}, err
+	}
+
+	return result, nil
+}
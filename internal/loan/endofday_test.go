@@ -0,0 +1,157 @@
+package loan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunEndOfDay(t *testing.T) {
+	t.Parallel()
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	endOfYesterday := model.BusinessDate(time.Now())
+
+	newService := func(g *WithT) (*loan.LoanService, model.InstallmentLoan, model.InstallmentLoan) {
+		memStorage := memorystorage.NewLoanMemoryStorage()
+
+		// 4 billings are overdue, the second one flags the loan
+		late := startedLoan(g, memStorage, 30)
+		onTime := startedLoan(g, memStorage, 3)
+
+		completed := startedLoan(g, memStorage, 30)
+		completed.IsCompleted = true
+		g.Expect(memStorage.UpdateLoan(completed.ID, completed)).To(Succeed())
+
+		loanService := loan.NewLoanService(memStorage,
+			loan.WithLateFeePolicy(model.LateFeePolicy{FixedFee: currency.NewRupiah(5000, 0)}),
+		)
+
+		return loanService, late, onTime
+	}
+
+	t.Run("Sweeps the Active Loans", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		loanService, late, onTime := newService(g)
+
+		results := map[model.LoanID]model.EndOfDayResult{}
+		run, err := loanService.RunEndOfDay(context.Background(), yesterday, func(result model.EndOfDayResult) {
+			results[result.LoanID] = result
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(run.RunKey).To(Equal(yesterday.Format(time.DateOnly)))
+		g.Expect(run.IsFinished()).To(BeTrue())
+		g.Expect(run.Processed).To(Equal(2))
+		g.Expect(run.Delinquent).To(Equal(1))
+		g.Expect(run.Charged).To(Equal(currency.NewRupiah(20000, 0)))
+
+		g.Expect(results).To(HaveLen(2))
+		g.Expect(results[late.ID].IsDelinquent).To(BeTrue())
+		g.Expect(results[late.ID].Charged).To(Equal(currency.NewRupiah(20000, 0)))
+		g.Expect(results[late.ID].Aging.Bucket).To(Equal(model.Bucket1To30))
+		g.Expect(results[onTime.ID].IsDelinquent).To(BeFalse())
+		g.Expect(results[onTime.ID].Aging.Bucket).To(Equal(model.BucketCurrent))
+
+		history, err := loanService.GetDelinquencyHistory(late.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(1))
+		g.Expect(history[0].Kind).To(Equal(model.DelinquencyEventDelinquent))
+		g.Expect(history[0].At).To(Equal(endOfYesterday))
+
+		status, err := loanService.GetLoan(late.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(status.LateFee).To(Equal(currency.NewRupiah(20000, 0)))
+		g.Expect(status.AgedAt).To(Equal(endOfYesterday))
+
+		// same business date, nothing is swept nor charged again
+		again, err := loanService.RunEndOfDay(context.Background(), yesterday, func(result model.EndOfDayResult) {
+			t.Errorf("unexpected sweep of %s", result.LoanID)
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(again).To(Equal(run))
+
+		status, err = loanService.GetLoan(late.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(status.LateFee).To(Equal(currency.NewRupiah(20000, 0)))
+	})
+
+	t.Run("Resumes an Interrupted Run", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		loanService, _, _ := newService(g)
+
+		// crash after the first loan
+		ctx, cancel := context.WithCancel(context.Background())
+		var swept []model.LoanID
+		run, err := loanService.RunEndOfDay(ctx, yesterday, func(result model.EndOfDayResult) {
+			swept = append(swept, result.LoanID)
+			cancel()
+		})
+		g.Expect(err).To(MatchError(context.Canceled))
+		g.Expect(run.IsFinished()).To(BeFalse())
+		g.Expect(run.Processed).To(Equal(1))
+		g.Expect(run.Checkpoint).To(Equal(swept[0]))
+
+		run, err = loanService.RunEndOfDay(context.Background(), yesterday, func(result model.EndOfDayResult) {
+			swept = append(swept, result.LoanID)
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(run.IsFinished()).To(BeTrue())
+		g.Expect(run.Processed).To(Equal(2))
+		g.Expect(run.Charged).To(Equal(currency.NewRupiah(20000, 0)))
+
+		// every loan is swept once
+		g.Expect(swept).To(HaveLen(2))
+		g.Expect(swept[0]).ToNot(Equal(swept[1]))
+	})
+
+	t.Run("Racing Run of the Same Date", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		loanService, _, _ := newService(g)
+
+		// another run resumes the same business date after the first loan and finishes it
+		var racing model.EndOfDayRun
+		run, err := loanService.RunEndOfDay(context.Background(), yesterday, func(model.EndOfDayResult) {
+			if racing.RunKey != "" {
+				return
+			}
+
+			var err error
+			racing, err = loanService.RunEndOfDay(context.Background(), yesterday, func(model.EndOfDayResult) {})
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+		g.Expect(err).To(MatchError(model.ErrEndOfDayRunInProgress))
+		g.Expect(run.Processed).To(Equal(1))
+
+		g.Expect(racing.IsFinished()).To(BeTrue())
+		g.Expect(racing.Processed).To(Equal(2))
+		g.Expect(racing.Charged).To(Equal(currency.NewRupiah(20000, 0)))
+
+		// the finished run is kept
+		again, err := loanService.RunEndOfDay(context.Background(), yesterday, func(model.EndOfDayResult) {})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(again).To(Equal(racing))
+	})
+
+	t.Run("Business Date not Over", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		loanService, _, _ := newService(g)
+
+		_, err := loanService.RunEndOfDay(context.Background(), time.Now(), func(model.EndOfDayResult) {})
+		g.Expect(err).To(MatchError(model.ErrBusinessDayNotOver))
+	})
+}
@@ -0,0 +1,139 @@
+package loan
+
+import (
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"github.com/shopspring/decimal"
+)
+
+// InterestCalculator splits the repayment of every term into principal and interest
+type InterestCalculator interface {
+	// Split returns a billing for every term without the due date, `Repayment` is `Principal` + `Interest`.
+	// `termRates` is the interest rate of every term (see `TermRates`), every computed amount is rounded to the sen
+	// with `rounding` and the rounding remainder lands on the last terms, so the principal of the billings always adds
+	// up to `principal`
+	Split(principal currency.Rupiah, termRates []decimal.Decimal, rounding model.RoundingMode) []model.Billing
+}
+
+func defaultInterestCalculators() map[model.InterestMethod]InterestCalculator {
+	return map[model.InterestMethod]InterestCalculator{
+		model.InterestFlat:             FlatInterest{},
+		model.InterestDecliningBalance: DecliningBalanceInterest{},
+		model.InterestAnnuity:          AnnuityInterest{},
+	}
+}
+
+// FlatInterest charges the rate of every term on the principal at start, the total interest is spread evenly
+type FlatInterest struct{}
+
+func (FlatInterest) Split(principal currency.Rupiah, termRates []decimal.Decimal, rounding model.RoundingMode) []model.Billing {
+	loanTerm := len(termRates)
+	totalInterest := toRupiah(sen(principal).Mul(sum(termRates)), rounding)
+
+	principals := spread(principal, loanTerm)
+	interests := spread(totalInterest, loanTerm)
+
+	billings := make([]model.Billing, 0, loanTerm)
+	for i := range loanTerm {
+		billings = append(billings, newBilling(i+1, principals[i], interests[i]))
+	}
+
+	return billings
+}
+
+// DecliningBalanceInterest repays the same principal every term and charges the rate of the term on the principal
+// that is still owed
+type DecliningBalanceInterest struct{}
+
+func (DecliningBalanceInterest) Split(principal currency.Rupiah, termRates []decimal.Decimal, rounding model.RoundingMode) []model.Billing {
+	billings := make([]model.Billing, 0, len(termRates))
+	balance := principal
+	for i, termPrincipal := range spread(principal, len(termRates)) {
+		billings = append(billings, newBilling(i+1, termPrincipal, interestOn(balance, termRates[i], rounding)))
+		balance = balance.Subtract(termPrincipal)
+	}
+
+	return billings
+}
+
+// AnnuityInterest repays the same installment every term, computed with the average rate of the terms. The interest
+// is charged with the rate of the term on the principal that is still owed and the rest of the installment repays
+// the principal. The last term repays whatever principal is left
+type AnnuityInterest struct{}
+
+func (AnnuityInterest) Split(principal currency.Rupiah, termRates []decimal.Decimal, rounding model.RoundingMode) []model.Billing {
+	loanTerm := len(termRates)
+	rate := sum(termRates).Div(decimal.NewFromInt(int64(loanTerm)))
+	if rate.IsZero() {
+		return DecliningBalanceInterest{}.Split(principal, termRates, rounding)
+	}
+
+	// installment = P * r * (1+r)^n / ((1+r)^n - 1)
+	growth := decimal.NewFromInt(1).Add(rate).Pow(decimal.NewFromInt(int64(loanTerm)))
+	installment := toRupiah(sen(principal).Mul(rate).Mul(growth).Div(growth.Sub(decimal.NewFromInt(1))), rounding)
+
+	billings := make([]model.Billing, 0, loanTerm)
+	balance := principal
+	for i := range loanTerm {
+		interest := interestOn(balance, termRates[i], rounding)
+
+		termPrincipal := installment.Subtract(interest)
+		if i == loanTerm-1 {
+			termPrincipal = balance
+		}
+
+		billings = append(billings, newBilling(i+1, termPrincipal, interest))
+		balance = balance.Subtract(termPrincipal)
+	}
+
+	return billings
+}
+
+func newBilling(term int, principal, interest currency.Rupiah) model.Billing {
+	return model.Billing{
+		TermNumber: term,
+		Repayment:  principal.Add(interest),
+		Principal:  principal,
+		Interest:   interest,
+		PaidAmount: currency.NewRupiah(0, 0),
+	}
+}
+
+func interestOn(balance currency.Rupiah, rate decimal.Decimal, rounding model.RoundingMode) currency.Rupiah {
+	return toRupiah(sen(balance).Mul(rate), rounding)
+}
+
+// spread splits `amount` into `parts` shares of the floored common share, the remainder is handed out one sen at a
+// time to the last shares so the shares always add up to `amount` and never differ by more than a sen
+func spread(amount currency.Rupiah, parts int) []currency.Rupiah {
+	share := amount.Divide(parts)
+	remainder := int(amount.Subtract(share.Multiply(parts)))
+
+	shares := make([]currency.Rupiah, parts)
+	for i := range parts {
+		shares[i] = share
+		if i >= parts-remainder {
+			shares[i] = share.Add(currency.NewRupiah(0, 1))
+		}
+	}
+
+	return shares
+}
+
+func sum(rates []decimal.Decimal) decimal.Decimal {
+	total := decimal.Zero
+	for _, r := range rates {
+		total = total.Add(r)
+	}
+
+	return total
+}
+
+func sen(amount currency.Rupiah) decimal.Decimal {
+	return decimal.NewFromInt(int64(amount))
+}
+
+// toRupiah rounds to the nearest sen
+func toRupiah(amountInSen decimal.Decimal, rounding model.RoundingMode) currency.Rupiah {
+	return currency.Rupiah(rounding.Round(amountInSen, 0).IntPart())
+}
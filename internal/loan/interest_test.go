@@ -0,0 +1,417 @@
+package loan_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+// go test ./internal/loan -run TestInterestCalculatorGolden -update
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func formatSchedule(billings []model.Billing) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%4s %16s %16s %16s\n", "term", "principal", "interest", "repayment")
+
+	var principal, interest, repayment currency.Rupiah
+	for _, b := range billings {
+		fmt.Fprintf(&buf, "%4d %16s %16s %16s\n", b.TermNumber, b.Principal.DecimalString(), b.Interest.DecimalString(), b.Repayment.DecimalString())
+
+		principal = principal.Add(b.Principal)
+		interest = interest.Add(b.Interest)
+		repayment = repayment.Add(b.Repayment)
+	}
+	fmt.Fprintf(&buf, "%4s %16s %16s %16s\n", "sum", principal.DecimalString(), interest.DecimalString(), repayment.DecimalString())
+
+	return buf.Bytes()
+}
+
+func TestInterestCalculatorGolden(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		golden             string
+		calculator         loan.InterestCalculator
+		principal          currency.Rupiah
+		annualInterestRate model.BPS
+		frequency          model.Frequency
+		loanTerm           int
+		rateBasis          model.RateBasis
+		dayCount           model.DayCount
+		rounding           model.RoundingMode
+	}{
+		{
+			golden:             "flat_weekly_50.golden",
+			calculator:         loan.FlatInterest{},
+			principal:          currency.NewRupiah(5000000, 0),
+			annualInterestRate: model.BPS(1000),
+			frequency:          model.FrequencyWeekly,
+			loanTerm:           50,
+			rateBasis:          model.RatePerTenor,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "flat_weekly_50_annual_act_365.golden",
+			calculator:         loan.FlatInterest{},
+			principal:          currency.NewRupiah(5000000, 0),
+			annualInterestRate: model.BPS(1000),
+			frequency:          model.FrequencyWeekly,
+			loanTerm:           50,
+			rateBasis:          model.RateAnnual,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "flat_monthly_12_monthly_30_360.golden",
+			calculator:         loan.FlatInterest{},
+			principal:          currency.NewRupiah(6000000, 0),
+			annualInterestRate: model.BPS(150),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           12,
+			rateBasis:          model.RateMonthly,
+			dayCount:           model.DayCount30E360,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "flat_monthly_7_half_even.golden",
+			calculator:         loan.FlatInterest{},
+			principal:          currency.NewRupiah(2000000, 0),
+			annualInterestRate: model.BPS(1050),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           7,
+			rateBasis:          model.RatePerTenor,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "flat_monthly_7_floor.golden",
+			calculator:         loan.FlatInterest{},
+			principal:          currency.NewRupiah(2000000, 0),
+			annualInterestRate: model.BPS(1050),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           7,
+			rateBasis:          model.RatePerTenor,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundFloor,
+		},
+		{
+			golden:             "declining_balance_monthly_12.golden",
+			calculator:         loan.DecliningBalanceInterest{},
+			principal:          currency.NewRupiah(12000000, 0),
+			annualInterestRate: model.BPS(1200),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           12,
+			rateBasis:          model.RateAnnual,
+			dayCount:           model.DayCount30E360,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "declining_balance_monthly_12_act_365.golden",
+			calculator:         loan.DecliningBalanceInterest{},
+			principal:          currency.NewRupiah(12000000, 0),
+			annualInterestRate: model.BPS(1200),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           12,
+			rateBasis:          model.RateAnnual,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "annuity_monthly_12.golden",
+			calculator:         loan.AnnuityInterest{},
+			principal:          currency.NewRupiah(12000000, 0),
+			annualInterestRate: model.BPS(1200),
+			frequency:          model.FrequencyMonthly,
+			loanTerm:           12,
+			rateBasis:          model.RateAnnual,
+			dayCount:           model.DayCount30E360,
+			rounding:           model.RoundHalfEven,
+		},
+		{
+			golden:             "annuity_biweekly_7.golden",
+			calculator:         loan.AnnuityInterest{},
+			principal:          currency.NewRupiah(1000000, 0),
+			annualInterestRate: model.BPS(1850),
+			frequency:          model.FrequencyBiweekly,
+			loanTerm:           7,
+			rateBasis:          model.RateAnnual,
+			dayCount:           model.DayCountAct365,
+			rounding:           model.RoundHalfUp,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.golden, func(t *testing.T) {
+			g := NewWithT(t)
+
+			installmentLoan := model.InstallmentLoan{
+				Loan: model.Loan{
+					Principal:          tc.principal,
+					AnnualInterestRate: tc.annualInterestRate,
+					StartDate:          time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC),
+					RateBasis:          tc.rateBasis,
+					DayCount:           tc.dayCount,
+				},
+				Frequency: tc.frequency,
+				LoanTerm:  tc.loanTerm,
+			}
+
+			billings := tc.calculator.Split(tc.principal, loan.TermRates(installmentLoan), tc.rounding)
+			g.Expect(billings).To(HaveLen(tc.loanTerm))
+
+			actual := formatSchedule(billings)
+
+			path := filepath.Join("testdata", tc.golden)
+			if *update {
+				g.Expect(os.WriteFile(path, actual, 0o644)).To(Succeed())
+			}
+
+			expected, err := os.ReadFile(path)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(string(actual)).To(Equal(string(expected)))
+		})
+	}
+}
+
+func TestInterestMethod(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	principal := currency.NewRupiah(1000000, 0)
+
+	testCases := []struct {
+		name          string
+		method        model.InterestMethod
+		expectedError error
+	}{
+		{name: "Default to Flat"},
+		{name: "Flat", method: model.InterestFlat},
+		{name: "Declining Balance", method: model.InterestDecliningBalance},
+		{name: "Annuity", method: model.InterestAnnuity},
+		{name: "Unknown", method: "compound", expectedError: model.ErrUnknownInterestMethod},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+			createdLoan, err := loanService.CreateLoan(model.LoanParam{
+				Principal:          principal,
+				AnnualInterestRate: model.BPS(1200),
+				Frequency:          model.FrequencyMonthly,
+				LoanTerm:           6,
+				InterestMethod:     tc.method,
+			})
+			if tc.expectedError != nil {
+				g.Expect(err).To(Equal(tc.expectedError))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			var totalInterest, totalRepayment currency.Rupiah
+			for _, b := range billings {
+				g.Expect(b.Repayment).To(Equal(b.Principal.Add(b.Interest)))
+				totalInterest = totalInterest.Add(b.Interest)
+				totalRepayment = totalRepayment.Add(b.Repayment)
+			}
+
+			g.Expect(createdLoan.TotalInterest).To(Equal(totalInterest))
+			g.Expect(createdLoan.OutstandingBalance).To(Equal(totalRepayment))
+		})
+	}
+}
+
+func TestScheduleReconciles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	methods := []model.InterestMethod{model.InterestFlat, model.InterestDecliningBalance, model.InterestAnnuity}
+	roundings := []model.RoundingMode{model.RoundHalfEven, model.RoundHalfUp, model.RoundFloor}
+	frequencies := []model.Frequency{model.FrequencyWeekly, model.FrequencyBiweekly, model.FrequencyMonthly}
+
+	for _, method := range methods {
+		for _, rounding := range roundings {
+			loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithRounding(rounding))
+
+			for _, frequency := range frequencies {
+				createdLoan, err := loanService.CreateLoan(model.LoanParam{
+					Principal:          currency.NewRupiah(1234567, 89),
+					AnnualInterestRate: model.BPS(1333),
+					Frequency:          frequency,
+					LoanTerm:           13,
+					InterestMethod:     method,
+				})
+				g.Expect(err).ToNot(HaveOccurred())
+
+				billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+				g.Expect(err).ToNot(HaveOccurred())
+
+				var principal, interest, repayment currency.Rupiah
+				for _, b := range billings {
+					g.Expect(b.Principal).To(BeNumerically(">", 0))
+					principal = principal.Add(b.Principal)
+					interest = interest.Add(b.Interest)
+					repayment = repayment.Add(b.Repayment)
+				}
+
+				// to the sen, whatever the method and the rounding
+				g.Expect(principal).To(Equal(createdLoan.Principal), "%s %s %s", method, rounding, frequency)
+				g.Expect(interest).To(Equal(createdLoan.TotalInterest), "%s %s %s", method, rounding, frequency)
+				g.Expect(repayment).To(Equal(createdLoan.OutstandingBalance), "%s %s %s", method, rounding, frequency)
+			}
+		}
+	}
+}
+
+func TestSplitSmallAmounts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	roundings := []model.RoundingMode{model.RoundHalfEven, model.RoundHalfUp, model.RoundFloor}
+	calculators := []loan.InterestCalculator{loan.FlatInterest{}, loan.DecliningBalanceInterest{}}
+
+	for _, rounding := range roundings {
+		for _, calculator := range calculators {
+			for _, principal := range []currency.Rupiah{currency.NewRupiah(0, 3), currency.NewRupiah(0, 5), currency.NewRupiah(0, 11)} {
+				// no interest so only the spread of the principal is seen
+				billings := calculator.Split(principal, make([]decimal.Decimal, 7), rounding)
+
+				var total currency.Rupiah
+				for _, b := range billings {
+					// never negative, and at most a sen apart from the others
+					g.Expect(b.Principal).To(BeNumerically(">=", principal.Divide(7)), "%T %s %s", calculator, rounding, principal)
+					g.Expect(b.Principal).To(BeNumerically("<=", principal.Divide(7).Add(currency.NewRupiah(0, 1))), "%T %s %s", calculator, rounding, principal)
+					total = total.Add(b.Principal)
+				}
+				g.Expect(total).To(Equal(principal), "%T %s %s", calculator, rounding, principal)
+			}
+		}
+	}
+
+	// the remainder goes to the last installments
+	billings := loan.FlatInterest{}.Split(currency.NewRupiah(0, 5), make([]decimal.Decimal, 7), model.RoundHalfUp)
+	principals := make([]currency.Rupiah, 0, len(billings))
+	for _, b := range billings {
+		principals = append(principals, b.Principal)
+	}
+	g.Expect(principals).To(Equal([]currency.Rupiah{0, 0, 1, 1, 1, 1, 1}))
+}
+
+func TestRateBasis(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	principal := currency.NewRupiah(1000000, 0)
+
+	testCases := []struct {
+		name                  string
+		annualInterestRate    model.BPS
+		frequency             model.Frequency
+		loanTerm              int
+		rateBasis             model.RateBasis
+		dayCount              model.DayCount
+		expectedRateBasis     model.RateBasis
+		expectedDayCount      model.DayCount
+		expectedTotalInterest currency.Rupiah
+	}{
+		{
+			name:                  "Default to Annual ACT/365",
+			annualInterestRate:    model.BPS(1000),
+			frequency:             model.FrequencyWeekly,
+			loanTerm:              10,
+			expectedRateBasis:     model.RateAnnual,
+			expectedDayCount:      model.DayCountAct365,
+			expectedTotalInterest: currency.NewRupiah(19178, 8), // 10% * 70/365
+		},
+		{
+			name:                  "Per Tenor",
+			annualInterestRate:    model.BPS(1000),
+			frequency:             model.FrequencyWeekly,
+			loanTerm:              10,
+			rateBasis:             model.RatePerTenor,
+			expectedRateBasis:     model.RatePerTenor,
+			expectedDayCount:      model.DayCountAct365,
+			expectedTotalInterest: currency.NewRupiah(100000, 0),
+		},
+		{
+			name:                  "Annual 30/360 Monthly",
+			annualInterestRate:    model.BPS(1200),
+			frequency:             model.FrequencyMonthly,
+			loanTerm:              6,
+			rateBasis:             model.RateAnnual,
+			dayCount:              model.DayCount30E360,
+			expectedRateBasis:     model.RateAnnual,
+			expectedDayCount:      model.DayCount30E360,
+			expectedTotalInterest: currency.NewRupiah(60000, 0), // 1% a month
+		},
+		{
+			name:                  "Monthly Flat",
+			annualInterestRate:    model.BPS(150),
+			frequency:             model.FrequencyMonthly,
+			loanTerm:              6,
+			rateBasis:             model.RateMonthly,
+			dayCount:              model.DayCount30E360,
+			expectedRateBasis:     model.RateMonthly,
+			expectedDayCount:      model.DayCount30E360,
+			expectedTotalInterest: currency.NewRupiah(90000, 0), // 1.5% a month
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+			createdLoan, err := loanService.CreateLoan(model.LoanParam{
+				Principal:          principal,
+				AnnualInterestRate: tc.annualInterestRate,
+				Frequency:          tc.frequency,
+				LoanTerm:           tc.loanTerm,
+				RateBasis:          tc.rateBasis,
+				DayCount:           tc.dayCount,
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(createdLoan.RateBasis).To(Equal(tc.expectedRateBasis))
+			g.Expect(createdLoan.DayCount).To(Equal(tc.expectedDayCount))
+			g.Expect(createdLoan.TotalInterest).To(Equal(tc.expectedTotalInterest))
+		})
+	}
+}
+
+// everyTermTheSame is a custom method plugged through the option
+type everyTermTheSame struct{}
+
+func (everyTermTheSame) Split(principal currency.Rupiah, termRates []decimal.Decimal, rounding model.RoundingMode) []model.Billing {
+	return loan.DecliningBalanceInterest{}.Split(principal, make([]decimal.Decimal, len(termRates)), rounding)
+}
+
+func TestPluggableInterestCalculator(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithInterestCalculator("interest_free", everyTermTheSame{}))
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1200),
+		LoanTerm:           4,
+		InterestMethod:     "interest_free",
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(createdLoan.TotalInterest).To(BeZero())
+	g.Expect(createdLoan.InstallmentAmount).To(Equal(currency.NewRupiah(250000, 0)))
+}
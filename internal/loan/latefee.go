@@ -0,0 +1,146 @@
+package loan
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"github.com/shopspring/decimal"
+)
+
+// AccrueLateCharges charges the late fees and the penalty interest of the overdue billings up to `asOf`, it returns
+// how much has been charged. Accruing twice at the same `asOf` charges nothing the second time
+func (ls *LoanService) AccrueLateCharges(loanID model.LoanID, asOf time.Time) (currency.Rupiah, error) {
+	asOf = asOf.UTC()
+
+	var charged currency.Rupiah
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		charged, err = accrueLateCharges(tx, loanID, asOf, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return charged, nil
+}
+
+// accrueLateCharges persists the late charges, they are added to the billings, the outstanding balance and the
+// `LateFee` of the delinquency status. Nothing is charged for the days after `now`, they have not come yet
+func accrueLateCharges(tx ports.LoanStorage, loanID model.LoanID, asOf time.Time, now time.Time, policy model.LateFeePolicy, rounding model.RoundingMode) (currency.Rupiah, error) {
+	if policy.IsZero() {
+		return 0, nil
+	}
+
+	asOf = chargedUntil(asOf, now)
+
+	loan, err := tx.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return 0, err
+	}
+
+	// nothing is charged anymore once written off
+	if loan.IsCompleted || loan.IsWrittenOff() {
+		return 0, nil
+	}
+
+	billings, err := tx.GetBillings(loanID)
+	if err != nil {
+		return 0, err
+	}
+
+	accrued, charged := chargeLate(billings, asOf, policy, rounding)
+	if len(accrued) == 0 {
+		return 0, nil
+	}
+
+	err = tx.UpdateBillings(loanID, accrued)
+	if err != nil {
+		return 0, err
+	}
+
+	if charged == 0 {
+		return 0, nil
+	}
+
+	loanUpdateParams := loan.InstallmentLoan
+	loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Add(charged)
+
+	err = tx.UpdateLoan(loanID, loanUpdateParams)
+	if err != nil {
+		return 0, err
+	}
+
+	delinquencyUpdateParams := loan.DelinquencyStatus
+	delinquencyUpdateParams.LateFee = loan.LateFee.Add(charged)
+
+	err = tx.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
+	if err != nil {
+		return 0, err
+	}
+
+	return charged, nil
+}
+
+// chargedUntil tells up to when the late charges at `asOf` are charged, the days after `now` have not come yet
+func chargedUntil(asOf time.Time, now time.Time) time.Time {
+	if asOf.After(now) {
+		return now.UTC()
+	}
+
+	return asOf
+}
+
+// chargeLate adds the late charges up to `asOf` to the unpaid billings overdue by more than the grace days. The fixed
+// fee and the overdue rate are charged once as `Fee` the first time, the daily penalty is charged as `Penalty` for
+// every day since the last accrual (the due date the first time). `billings` is updated in place, the accrued
+// billings and the total charged are returned
+func chargeLate(billings []model.Billing, asOf time.Time, policy model.LateFeePolicy, rounding model.RoundingMode) ([]model.Billing, currency.Rupiah) {
+	var (
+		accrued []model.Billing
+		charged currency.Rupiah
+	)
+
+	for i := range billings {
+		b := &billings[i]
+
+		if b.IsPaid() || !asOf.After(b.PaymentDueDate.AddDate(0, 0, policy.GraceDays)) {
+			continue
+		}
+
+		// the late charges themselves are not charged again
+		overdue := b.Principal.Subtract(b.PaidPrincipal).Add(b.Interest.Subtract(b.PaidInterest))
+
+		var fee currency.Rupiah
+		if b.AccruedUntil.IsZero() {
+			fee = policy.FixedFee.Add(toRupiah(sen(overdue).Mul(policy.OverdueRate.Fraction()), rounding))
+			b.AccruedUntil = b.PaymentDueDate
+		}
+
+		days := model.DaysBetween(b.AccruedUntil, asOf)
+		if fee == 0 && days <= 0 {
+			continue
+		}
+
+		penalty := toRupiah(sen(overdue).Mul(policy.DailyPenaltyRate.Fraction()).Mul(decimal.NewFromInt(int64(days))), rounding)
+		b.AccruedUntil = b.AccruedUntil.AddDate(0, 0, days)
+
+		if policy.Cap > 0 {
+			room := max(policy.Cap.Subtract(b.Fee).Subtract(b.Penalty), 0)
+			fee = min(fee, room)
+			penalty = min(penalty, room.Subtract(fee))
+		}
+
+		b.Fee = b.Fee.Add(fee)
+		b.Penalty = b.Penalty.Add(penalty)
+		b.Repayment = b.Repayment.Add(fee).Add(penalty)
+		charged = charged.Add(fee).Add(penalty)
+
+		accrued = append(accrued, *b)
+	}
+
+	return accrued, charged
+}
@@ -0,0 +1,190 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+func TestAccrueLateCharges(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+		AllocationPolicy:   model.AllocationExactDue,
+	}
+
+	policy := model.LateFeePolicy{
+		FixedFee:         currency.NewRupiah(5000, 0),
+		OverdueRate:      model.BPS(100), // 1% once
+		DailyPenaltyRate: model.BPS(10),  // 0.1% a day
+	}
+
+	t.Run("Fee Once and Penalty Every Day", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock), loan.WithLateFeePolicy(policy))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(15)
+
+		charged, err := loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 7))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(BeZero(), "not overdue on the due date")
+
+		// 5000 + 1% of 110000, then 3 days of 0.1% of 110000
+		charged, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(Equal(currency.NewRupiah(6100+330, 0)))
+
+		charged, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(BeZero(), "already accrued")
+
+		// 5 more days of the first billing, the second is overdue by a day
+		charged, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 15))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(Equal(currency.NewRupiah(550+6100+110, 0)))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].Fee).To(Equal(currency.NewRupiah(6100, 0)))
+		g.Expect(billings[0].Penalty).To(Equal(currency.NewRupiah(880, 0)))
+		g.Expect(billings[0].Repayment).To(Equal(currency.NewRupiah(110000+6100+880, 0)))
+		g.Expect(billings[1].Fee).To(Equal(currency.NewRupiah(6100, 0)))
+		g.Expect(billings[1].Penalty).To(Equal(currency.NewRupiah(110, 0)))
+		g.Expect(billings[2].Fee).To(BeZero())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.LateFee).To(Equal(currency.NewRupiah(13190, 0)))
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance.Add(currency.NewRupiah(13190, 0))))
+	})
+
+	t.Run("Capped per Billing", func(t *testing.T) {
+		capped := policy
+		capped.Cap = currency.NewRupiah(7000, 0)
+
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock), loan.WithLateFeePolicy(capped))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(67)
+
+		_, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 8))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 67))
+		g.Expect(err).ToNot(HaveOccurred())
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].Fee.Add(billings[0].Penalty)).To(Equal(capped.Cap))
+		g.Expect(billings[0].Fee).To(Equal(currency.NewRupiah(6100, 0)))
+	})
+
+	t.Run("Grace Days", func(t *testing.T) {
+		graced := policy
+		graced.GraceDays = 3
+
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock), loan.WithLateFeePolicy(graced))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(11)
+
+		charged, err := loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 10))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(BeZero())
+
+		// the penalty still runs from the due date
+		charged, err = loanService.AccrueLateCharges(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 11))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(Equal(currency.NewRupiah(6100+440, 0)))
+	})
+
+	t.Run("Required by the Payment", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock), loan.WithLateFeePolicy(policy))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(10)
+		payAt := clock.Now()
+
+		quote, err := loanService.QuotePayoff(createdLoan.ID, payAt)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(quote.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance.Add(currency.NewRupiah(6430, 0))))
+
+		// the first billing is overdue and the second is running
+		err = loanService.RecordPayment(createdLoan.ID, payAt, createdLoan.InstallmentAmount.Multiply(2))
+		g.Expect(err).To(Equal(model.ErrMismatchPayment))
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, payAt, currency.NewRupiah(220000+6430, 0))).To(Succeed())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.Payments).To(HaveLen(1))
+		g.Expect(updatedLoan.Payments[0].Penalty).To(Equal(currency.NewRupiah(330, 0)))
+		g.Expect(updatedLoan.Payments[0].Fee).To(Equal(currency.NewRupiah(6100, 0)))
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance.Subtract(createdLoan.InstallmentAmount.Multiply(2))))
+	})
+	t.Run("Not Charged Ahead", func(t *testing.T) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock), loan.WithLateFeePolicy(policy))
+
+		flexible := param
+		flexible.AllocationPolicy = model.AllocationHoldAsCredit
+
+		createdLoan, err := loanService.CreateLoan(flexible)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// every billing would be overdue by then, none is yet
+		ahead := clock.Now().AddDate(0, 6, 0)
+
+		payment, err := loanService.MakePayment(createdLoan.ID, ahead, currency.NewRupiah(1000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.LateFee).To(BeZero())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance.Subtract(currency.NewRupiah(1000, 0))))
+
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, ahead, "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		quote, err := loanService.QuotePayoff(createdLoan.ID, ahead)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(quote.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance))
+
+		_, err = loanService.SettleLoan(createdLoan.ID, ahead, quote.PayoffAmount)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err = loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.LateFee).To(BeZero())
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		for _, b := range billings {
+			g.Expect(b.AccruedUntil).To(BeZero())
+		}
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/bahrunnur/loan-billing-service/internal/model"
 	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock"
 	"github.com/bahrunnur/loan-billing-service/pkg/currency"
 	"go.jetify.com/typeid"
 )
@@ -13,213 +14,280 @@ import (
 
 // LoanStorageAdapter is a consumer interface to interact with storage adapter (repo)
 type LoanStorageAdapter interface {
-	ports.LoanCreator
-	ports.LoanGetter
-	ports.LoanUpdater
-	ports.DelinquencyStatusCreator
-	ports.DelinquencyStatusGetter
-	ports.DelinquencyStatusUpdater
-	ports.PaymentInserter
-	ports.BillingInserter
-	ports.BillingGetter
-	ports.BillingUpdater
+	ports.LoanStorage
+	ports.UnitOfWork
 }
 
 // LoanService manages loan-related operations
 type LoanService struct {
-	storage LoanStorageAdapter
+	storage             LoanStorageAdapter
+	rebateRule          model.RebateRule
+	rounding            model.RoundingMode
+	lateFeePolicy       model.LateFeePolicy
+	delinquencyPolicy   model.DelinquencyPolicy
+	datingWindow        model.DatingWindow
+	interestCalculators map[model.InterestMethod]InterestCalculator
+	clock               ports.Clock
 }
 
-// NewLoanService creates a new LoanService
-func NewLoanService(storageAdapter LoanStorageAdapter) *LoanService {
-	return &LoanService{
-		storage: storageAdapter,
+// Option configures the LoanService
+type Option func(*LoanService)
+
+// WithRebateRule sets how the unearned interest is given back on early settlement (default: `RebateProRata`)
+func WithRebateRule(rule model.RebateRule) Option {
+	return func(ls *LoanService) {
+		ls.rebateRule = rule
 	}
 }
 
-// GetLoan to get all of the information from that loan including the delinquency status
-func (ls *LoanService) GetLoan(loanID model.LoanID) (model.WeeklyLoanFullInformation, error) {
-	return ls.storage.GetLoanFullInformation(loanID)
+// WithRounding sets how the computed amounts are rounded to the sen (default: `RoundHalfEven`)
+func WithRounding(mode model.RoundingMode) Option {
+	return func(ls *LoanService) {
+		ls.rounding = mode
+	}
 }
 
-// CreateLoan initializes a new loan with weekly payments
-func (ls *LoanService) CreateLoan(principal currency.Rupiah, annualInterestRate model.BPS, weeklyLoanTerm int) (model.WeeklyLoan, error) {
-	// NOTE: flat (not compound) interest rate: 1000bps (10%)
-
-	// validation, tiger style
-	if !(annualInterestRate >= 0) {
-		return model.WeeklyLoan{}, model.ErrNegativeInterest
+// WithLateFeePolicy sets what the overdue billings are charged (default: nothing)
+func WithLateFeePolicy(policy model.LateFeePolicy) Option {
+	return func(ls *LoanService) {
+		ls.lateFeePolicy = policy
 	}
+}
 
-	if !(principal.Rupiah() > 0 || principal.Sen() > 0) {
-		return model.WeeklyLoan{}, model.ErrNoPrincipal
+// WithDelinquencyPolicy sets when the new loans are delinquent (default: `model.DefaultDelinquencyPolicy`), the
+// existing loans keep the rule they were created with
+func WithDelinquencyPolicy(policy model.DelinquencyPolicy) Option {
+	return func(ls *LoanService) {
+		ls.delinquencyPolicy = policy
 	}
+}
 
-	if !(weeklyLoanTerm > 0) {
-		return model.WeeklyLoan{}, model.ErrNoTerm
+// WithDatingWindow sets how far the start date of a new loan may be from now (default: `model.DefaultDatingWindow`)
+func WithDatingWindow(window model.DatingWindow) Option {
+	return func(ls *LoanService) {
+		ls.datingWindow = window
 	}
+}
 
-	weeklyPrincipal := principal.Divide(weeklyLoanTerm)
-	// TODO: use more precise model like `Decimal`
-	weeklyInterest := weeklyPrincipal.Multiply(annualInterestRate.ToPercentage()).Divide(model.PERCENT)
-	weeklyPayment := weeklyPrincipal.Add(weeklyInterest)
-	totalInterest := weeklyInterest.Multiply(weeklyLoanTerm)
-	outstandingBalance := principal.Add(totalInterest)
+// WithInterestCalculator plugs (or replaces) the calculator of an interest method
+func WithInterestCalculator(method model.InterestMethod, calculator InterestCalculator) Option {
+	return func(ls *LoanService) {
+		ls.interestCalculators[method] = calculator
+	}
+}
 
-	loanID, err := typeid.New[model.LoanID]()
-	if err != nil {
-		return model.WeeklyLoan{}, err
+// WithClock sets where the current time comes from (default: the wall clock)
+func WithClock(clock ports.Clock) Option {
+	return func(ls *LoanService) {
+		ls.clock = clock
 	}
+}
 
-	now := time.Now().UTC()
-	loan := model.WeeklyLoan{
-		Loan: model.Loan{
-			ID:                 loanID,
-			Principal:          principal,
-			AnnualInterestRate: annualInterestRate,
-			StartDate:          now,
-			TotalInterest:      totalInterest,
-			OutstandingBalance: outstandingBalance,
-		},
-		LoanTermWeeks:  weeklyLoanTerm,
-		WeeklyPayment:  weeklyPayment,
-		WeeklyInterest: weeklyInterest,
+// NewLoanService creates a new LoanService
+func NewLoanService(storageAdapter LoanStorageAdapter, opts ...Option) *LoanService {
+	ls := &LoanService{
+		storage:             storageAdapter,
+		rebateRule:          model.RebateProRata,
+		rounding:            model.RoundHalfEven,
+		delinquencyPolicy:   model.DefaultDelinquencyPolicy(),
+		datingWindow:        model.DefaultDatingWindow(),
+		interestCalculators: defaultInterestCalculators(),
+		clock:               clock.Wall{},
 	}
-	delinquencyStatus := model.DelinquencyStatus{
-		LoanID:       loanID,
-		IsDelinquent: false,
-		LateFee:      currency.NewRupiah(0, 0),
+
+	for _, opt := range opts {
+		opt(ls)
 	}
-	billingParam := model.BillingParam{
-		LoanCreationDate: now,
-		NumberOfTerm:     weeklyLoanTerm,
-		RepaymentAmount:  weeklyPayment,
+
+	return ls
+}
+
+// GetLoan to get all of the information from that loan including the delinquency status
+func (ls *LoanService) GetLoan(loanID model.LoanID) (model.LoanFullInformation, error) {
+	return ls.storage.GetLoanFullInformation(loanID)
+}
+
+// GetBillingSchedule returns every billing of the loan ordered by term
+func (ls *LoanService) GetBillingSchedule(loanID model.LoanID) ([]model.Billing, error) {
+	return ls.storage.GetBillings(loanID)
+}
+
+// CreateLoan initializes a new loan with weekly, bi-weekly or monthly installments, the billings are scheduled from
+// the start date (now by default) or the first due date when given
+func (ls *LoanService) CreateLoan(param model.LoanParam) (model.InstallmentLoan, error) {
+	principal := param.Principal
+	annualInterestRate := param.AnnualInterestRate
+	loanTerm := param.LoanTerm
+
+	frequency := param.Frequency
+	if frequency == "" {
+		frequency = model.FrequencyWeekly
 	}
 
-	// =====
-	// TODO: wrap this in sql transaction block
-	err = ls.storage.CreateLoan(loan)
-	if err != nil {
-		return model.WeeklyLoan{}, err
+	interestMethod := param.InterestMethod
+	if interestMethod == "" {
+		interestMethod = model.InterestFlat
 	}
 
-	err = ls.storage.CreateDelinquencyStatus(loanID, delinquencyStatus)
-	if err != nil {
-		return model.WeeklyLoan{}, err
+	allocationPolicy := param.AllocationPolicy
+	if allocationPolicy == "" {
+		allocationPolicy = model.AllocationApplyToFuture
 	}
 
-	err = ls.storage.CreateBilling(loanID, billingParam)
-	if err != nil {
-		return model.WeeklyLoan{}, err
+	rateBasis := param.RateBasis
+	if rateBasis == "" {
+		rateBasis = model.RateAnnual
 	}
-	// =====
 
-	return loan, nil
-}
+	dayCount := param.DayCount
+	if dayCount == "" {
+		dayCount = model.DayCountAct365
+	}
 
-// CheckDelinquency check delinquency for a loan based on provided time (or IsDelinquent)
-func (ls *LoanService) CheckDelinquency(loanID model.LoanID, when time.Time) (bool, error) {
-	when = when.UTC() // making sure
+	now := ls.clock.Now().UTC()
 
-	loan, err := ls.storage.GetLoanWithDelinquency(loanID)
-	if err != nil {
-		return false, err
+	startDate := param.StartDate.UTC()
+	if param.StartDate.IsZero() {
+		startDate = now
 	}
 
-	// short circuit
-	if loan.IsDelinquent {
-		return true, nil
+	firstDueDate := param.FirstDueDate.UTC()
+
+	// validation, tiger style
+	if !(annualInterestRate >= 0) {
+		return model.InstallmentLoan{}, model.ErrNegativeInterest
 	}
 
-	isDelinquent, _, err := ls.ColdDelinquentFlag(loanID, when)
-	if err != nil {
-		return false, err
+	if !(principal.Rupiah() > 0 || principal.Sen() > 0) {
+		return model.InstallmentLoan{}, model.ErrNoPrincipal
 	}
 
-	return isDelinquent, nil
-}
+	if !(loanTerm > 0) {
+		return model.InstallmentLoan{}, model.ErrNoTerm
+	}
 
-// RecordPayment records a loan payment (or MakePayment) [idempotent operation]
-func (ls *LoanService) RecordPayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) error {
-	when = when.UTC() // make sure, as this service data is in UTC
+	if !frequency.IsValid() {
+		return model.InstallmentLoan{}, model.ErrUnknownFrequency
+	}
 
-	loan, err := ls.storage.GetLoanWithDelinquency(loanID)
-	if err != nil {
-		return err
+	if !ls.datingWindow.Contains(startDate, now) {
+		return model.InstallmentLoan{}, model.ErrStartDateOutOfWindow
 	}
 
-	// validation
-	if loan.IsCompleted {
-		return model.ErrRepaymentComplete
+	if !param.FirstDueDate.IsZero() && !frequency.IsValidFirstDueDate(startDate, firstDueDate) {
+		return model.InstallmentLoan{}, model.ErrInvalidFirstDueDate
 	}
 
-	// if delinquent, payment cannot be made, not sure about this as I don't know how delinquent account being handled
-	if loan.IsDelinquent {
-		return model.ErrPayInDelinquent
+	if !allocationPolicy.IsValid() {
+		return model.InstallmentLoan{}, model.ErrUnknownPolicy
 	}
 
-	// due dillligence check
-	isDelinquent, unfulfilledBilling, err := ls.ColdDelinquentFlag(loanID, when)
-	if err != nil {
-		return err
+	if !rateBasis.IsValid() {
+		return model.InstallmentLoan{}, model.ErrUnknownRateBasis
 	}
 
-	if isDelinquent {
-		return model.ErrPayInDelinquent
+	if !dayCount.IsValid() {
+		return model.InstallmentLoan{}, model.ErrUnknownDayCount
 	}
 
-	amountNeeded := currency.NewRupiah(0, 0)
-	missedPayments := 0
+	interestCalculator, ok := ls.interestCalculators[interestMethod]
+	if !ok {
+		return model.InstallmentLoan{}, model.ErrUnknownInterestMethod
+	}
 
-	for i, billing := range unfulfilledBilling {
-		if i+1 > model.MISSED_PAYMENT_THRESHOLD {
-			missedPayments++
-		}
-		amountNeeded = amountNeeded.Add(billing.Repayment)
+	loanID, err := typeid.New[model.LoanID]()
+	if err != nil {
+		return model.InstallmentLoan{}, err
 	}
 
-	// payment has to be exact with the weekly payment multiplier
-	if amountNeeded != paymentAmount {
-		return model.ErrMismatchPayment
+	loan := model.InstallmentLoan{
+		Loan: model.Loan{
+			ID:                 loanID,
+			Principal:          principal,
+			AnnualInterestRate: annualInterestRate,
+			StartDate:          startDate,
+			AllocationPolicy:   allocationPolicy,
+			InterestMethod:     interestMethod,
+			RateBasis:          rateBasis,
+			DayCount:           dayCount,
+			Product:            param.Product,
+			DelinquencyRule:    ls.delinquencyPolicy.RuleOf(param.Product),
+		},
+		Frequency:    frequency,
+		FirstDueDate: firstDueDate,
+		LoanTerm:     loanTerm,
+		TermsVersion: 1,
 	}
 
-	loanUpdateParams := loan.WeeklyLoan
-	delinquencyUpdateParams := loan.DelinquencyStatus
+	billings := interestCalculator.Split(principal, TermRates(loan), ls.rounding)
 
-	payment := model.Payment{
-		Date:          when,
-		Amount:        paymentAmount,
-		BalanceBefore: loan.OutstandingBalance,
+	totalInterest := currency.NewRupiah(0, 0)
+	for _, b := range billings {
+		totalInterest = totalInterest.Add(b.Interest)
 	}
 
-	if paymentAmount >= loan.OutstandingBalance {
-		payment.BalanceAfter = currency.NewRupiah(0, 0)
-		loanUpdateParams.IsCompleted = true
-	} else {
-		payment.BalanceAfter = loan.OutstandingBalance
-		loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Subtract(paymentAmount)
+	loan.TotalInterest = totalInterest
+	loan.OutstandingBalance = principal.Add(totalInterest)
+	loan.InstallmentAmount = billings[0].Repayment
+	loan.InstallmentInterest = billings[0].Interest
+	delinquencyStatus := model.DelinquencyStatus{
+		LoanID:         loanID,
+		IsDelinquent:   false,
+		LateFee:        currency.NewRupiah(0, 0),
+		Bucket:         model.BucketCurrent,
+		Kolektibilitas: model.KolektibilitasLancar,
+		AgedAt:         startDate, // a backdated loan can still be aged since its start
 	}
+	billings = billingSchedule(loan, billings)
 
-	err = ls.storage.RecordPayment(loanID, payment)
-	if err != nil {
-		return err
-	}
+	err = ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		err := tx.CreateLoan(loan)
+		if err != nil {
+			return err
+		}
+
+		err = tx.CreateDelinquencyStatus(loanID, delinquencyStatus)
+		if err != nil {
+			return err
+		}
 
-	err = ls.storage.UpdateLoan(loanID, loanUpdateParams)
+		return tx.CreateBillings(loanID, billings)
+	})
 	if err != nil {
-		return err
+		return model.InstallmentLoan{}, err
 	}
 
-	err = ls.storage.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
-	if err != nil {
-		return err
+	return loan, nil
+}
+
+// billingSchedule puts the split of every installment on the loan calendar
+func billingSchedule(loan model.InstallmentLoan, split []model.Billing) []model.Billing {
+	billings := make([]model.Billing, 0, len(split))
+	for _, b := range split {
+		b.LoanID = loan.ID
+		b.TermsVersion = loan.TermsVersion
+		b.PaymentDueDate = loan.DueDate(b.TermNumber)
+		billings = append(billings, b)
 	}
 
-	// update billing status until
-	err = ls.storage.PayBillingUntil(loanID, when)
-	if err != nil {
+	return billings
+}
+
+// CheckDelinquency check delinquency for a loan based on provided time, a transition found up to now is stored and
+// recorded (see `GetDelinquencyHistory`). A check in the future is only a projection, nothing is stored
+func (ls *LoanService) CheckDelinquency(loanID model.LoanID, when time.Time) (bool, error) {
+	when = when.UTC() // making sure
+
+	var isDelinquent bool
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		isDelinquent, err = checkDelinquency(tx, loanID, when, ls.clock.Now())
+
 		return err
+	})
+	if err != nil {
+		return false, err
 	}
 
-	return nil
+	return isDelinquent, nil
 }
@@ -1,12 +1,15 @@
 package loan_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
 	"github.com/bahrunnur/loan-billing-service/internal/loan"
 	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
 	"github.com/bahrunnur/loan-billing-service/pkg/currency"
 	. "github.com/onsi/gomega"
 	"go.jetify.com/typeid"
@@ -20,7 +23,10 @@ func TestCreateLoan(t *testing.T) {
 		name               string
 		principal          currency.Rupiah
 		annualInterestRate model.BPS
+		frequency          model.Frequency
 		loanTermWeekly     int
+		rateBasis          model.RateBasis
+		dayCount           model.DayCount
 		expectedError      error
 	}{
 		{
@@ -65,13 +71,45 @@ func TestCreateLoan(t *testing.T) {
 			loanTermWeekly:     0,
 			expectedError:      model.ErrNoTerm,
 		},
+		{
+			name:               "Monthly Loan",
+			principal:          currency.NewRupiah(1200000, 0),
+			annualInterestRate: model.BPS(1200),
+			frequency:          model.FrequencyMonthly,
+			loanTermWeekly:     12,
+			expectedError:      nil,
+		},
+		{
+			name:               "Unknown Frequency",
+			principal:          currency.NewRupiah(1000000, 0),
+			annualInterestRate: model.BPS(1000),
+			frequency:          "daily",
+			loanTermWeekly:     10,
+			expectedError:      model.ErrUnknownFrequency,
+		},
+		{
+			name:               "Unknown Rate Basis",
+			principal:          currency.NewRupiah(1000000, 0),
+			annualInterestRate: model.BPS(1000),
+			loanTermWeekly:     10,
+			rateBasis:          "quarterly",
+			expectedError:      model.ErrUnknownRateBasis,
+		},
+		{
+			name:               "Unknown Day Count",
+			principal:          currency.NewRupiah(1000000, 0),
+			annualInterestRate: model.BPS(1000),
+			loanTermWeekly:     10,
+			dayCount:           "act_360",
+			expectedError:      model.ErrUnknownDayCount,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			memStorage := memorystorage.NewLoanMemoryStorage()
 			loanService := loan.NewLoanService(memStorage)
-			createdLoan, err := loanService.CreateLoan(tc.principal, tc.annualInterestRate, tc.loanTermWeekly)
+			createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: tc.principal, AnnualInterestRate: tc.annualInterestRate, Frequency: tc.frequency, LoanTerm: tc.loanTermWeekly, RateBasis: tc.rateBasis, DayCount: tc.dayCount})
 
 			if tc.expectedError != nil {
 				g.Expect(err).To(HaveOccurred())
@@ -90,6 +128,149 @@ func TestCreateLoan(t *testing.T) {
 	}
 }
 
+func TestCreateBackdatedLoan(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 20, 15, 0, 0, 0, time.UTC)
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(),
+		loan.WithClock(clocktest.NewClock(now)),
+		loan.WithDatingWindow(model.DatingWindow{BackdateDays: 7, ForwardDateDays: 1}),
+	)
+
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1200000, 0),
+		AnnualInterestRate: model.BPS(1200),
+		Frequency:          model.FrequencyMonthly,
+		LoanTerm:           12,
+	}
+
+	testCases := []struct {
+		name          string
+		startDate     time.Time
+		firstDueDate  time.Time
+		expectedStart time.Time
+		expectedDue   time.Time
+		expectedError error
+	}{
+		{
+			name:          "Now by Default",
+			expectedStart: now,
+			expectedDue:   now.AddDate(0, 1, 0),
+		},
+		{
+			name:          "Disbursed Days Ago",
+			startDate:     now.AddDate(0, 0, -7),
+			expectedStart: now.AddDate(0, 0, -7),
+			expectedDue:   now.AddDate(0, 1, -7),
+		},
+		{
+			name:          "Disbursed Tomorrow",
+			startDate:     now.AddDate(0, 0, 1),
+			expectedStart: now.AddDate(0, 0, 1),
+			expectedDue:   now.AddDate(0, 1, 1),
+		},
+		{
+			name:          "Explicit First Due Date",
+			startDate:     now.AddDate(0, 0, -5),
+			firstDueDate:  time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expectedStart: now.AddDate(0, 0, -5),
+			expectedDue:   time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:          "Before the Backdate Window",
+			startDate:     now.AddDate(0, 0, -8),
+			expectedError: model.ErrStartDateOutOfWindow,
+		},
+		{
+			name:          "After the Forward Date Window",
+			startDate:     now.AddDate(0, 0, 2),
+			expectedError: model.ErrStartDateOutOfWindow,
+		},
+		{
+			name:          "First Due Date Before the Start",
+			startDate:     now,
+			firstDueDate:  now.AddDate(0, 0, -1),
+			expectedError: model.ErrInvalidFirstDueDate,
+		},
+		{
+			name:          "First Due Date After 2 Terms",
+			firstDueDate:  now.AddDate(0, 2, 1),
+			expectedError: model.ErrInvalidFirstDueDate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			param := param
+			param.StartDate = tc.startDate
+			param.FirstDueDate = tc.firstDueDate
+
+			createdLoan, err := loanService.CreateLoan(param)
+			if tc.expectedError != nil {
+				g.Expect(err).To(MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(createdLoan.StartDate).To(Equal(tc.expectedStart))
+
+			billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(billings[0].PaymentDueDate).To(Equal(tc.expectedDue))
+			g.Expect(billings[1].PaymentDueDate).To(Equal(tc.expectedDue.AddDate(0, 1, 0)))
+		})
+	}
+}
+
+var errBillingUnavailable = errors.New("billing unavailable")
+
+// failingBillingStorage fails every billing write made inside a unit of work
+type failingBillingStorage struct {
+	*memorystorage.LoanStorage
+}
+
+func (s failingBillingStorage) WithinTx(fn func(tx ports.LoanStorage) error) error {
+	return s.LoanStorage.WithinTx(func(tx ports.LoanStorage) error {
+		return fn(failingBillingTx{tx})
+	})
+}
+
+type failingBillingTx struct {
+	ports.LoanStorage
+}
+
+func (failingBillingTx) CreateBillings(model.LoanID, []model.Billing) error {
+	return errBillingUnavailable
+}
+
+func (failingBillingTx) UpdateBillings(model.LoanID, []model.Billing) error {
+	return errBillingUnavailable
+}
+
+func TestUseCaseIsAtomic(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	memStorage := memorystorage.NewLoanMemoryStorage()
+
+	// create loan fails at the last write
+	_, err := loan.NewLoanService(failingBillingStorage{memStorage}).CreateLoan(model.LoanParam{Principal: currency.NewRupiah(1000000, 0), AnnualInterestRate: model.BPS(1000), LoanTerm: 10, RateBasis: model.RatePerTenor})
+	g.Expect(err).To(Equal(errBillingUnavailable))
+
+	createdLoan, err := loan.NewLoanService(memStorage).CreateLoan(model.LoanParam{Principal: currency.NewRupiah(1000000, 0), AnnualInterestRate: model.BPS(1000), LoanTerm: 10, RateBasis: model.RatePerTenor})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	// payment fails at the last write, the payment and balance update should be rolled back
+	err = loan.NewLoanService(failingBillingStorage{memStorage}).RecordPayment(createdLoan.ID, createdLoan.StartDate.AddDate(0, 0, 2), createdLoan.InstallmentAmount)
+	g.Expect(err).To(Equal(errBillingUnavailable))
+
+	actual, err := memStorage.GetLoanFullInformation(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(actual.OutstandingBalance).To(Equal(createdLoan.OutstandingBalance))
+	g.Expect(actual.Payments).To(BeEmpty())
+}
+
 func TestCheckDelinquency(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -103,7 +284,7 @@ func TestCheckDelinquency(t *testing.T) {
 	principal := currency.NewRupiah(1000000, 0)
 	interestRate := model.BPS(1000)
 	loanTermWeekly := 10
-	createdLoan, err := loanService.CreateLoan(principal, interestRate, loanTermWeekly)
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor})
 	g.Expect(err).ToNot(HaveOccurred())
 
 	testCases := []struct {
@@ -164,48 +345,98 @@ func TestRecordPayment(t *testing.T) {
 
 	testCases := []struct {
 		name                string
-		paymentMultiplier   int
+		policy              model.AllocationPolicy
+		paymentAmount       currency.Rupiah
 		currentPaymentDate  time.Time
 		expectedError       error
 		expectedOutstanding currency.Rupiah
+		expectedCredit      currency.Rupiah
+		expectedCompleted   bool
 	}{
 		{
 			name:                "Successful - On-Time Payment",
-			paymentMultiplier:   1,
+			paymentAmount:       currency.NewRupiah(110000, 0),
 			currentPaymentDate:  now.AddDate(0, 0, 2),
 			expectedError:       nil,
 			expectedOutstanding: currency.NewRupiah(5500000-110000, 0),
 		},
 		{
 			name:                "Succesful - Missed a Payment",
-			paymentMultiplier:   2,
+			paymentAmount:       currency.NewRupiah(110000*2, 0),
 			currentPaymentDate:  now.AddDate(0, 0, (7*model.MISSED_PAYMENT_THRESHOLD)+1), // 8 days
 			expectedError:       nil,
 			expectedOutstanding: currency.NewRupiah(5500000-(110000*2), 0),
 		},
 		{
-			name:               "Fail - Pay All Term",
-			paymentMultiplier:  weeklyLoanTerm,
+			name:                "Successful - Partial Payment",
+			paymentAmount:       currency.NewRupiah(50000, 0),
+			currentPaymentDate:  now.AddDate(0, 0, 2),
+			expectedError:       nil,
+			expectedOutstanding: currency.NewRupiah(5500000-50000, 0),
+		},
+		{
+			name:                "Successful - Overpayment Applied to Future Terms",
+			paymentAmount:       currency.NewRupiah(150000, 0),
+			currentPaymentDate:  now.AddDate(0, 0, 2),
+			expectedError:       nil,
+			expectedOutstanding: currency.NewRupiah(5500000-150000, 0),
+		},
+		{
+			name:                "Successful - Overpayment Held as Credit",
+			policy:              model.AllocationHoldAsCredit,
+			paymentAmount:       currency.NewRupiah(150000, 0),
+			currentPaymentDate:  now.AddDate(0, 0, 2),
+			expectedError:       nil,
+			expectedOutstanding: currency.NewRupiah(5500000-110000, 0),
+			expectedCredit:      currency.NewRupiah(40000, 0),
+		},
+		{
+			name:                "Successful - Pay All Term",
+			paymentAmount:       currency.NewRupiah(110000*50, 0),
+			currentPaymentDate:  now.AddDate(0, 0, 2),
+			expectedError:       nil,
+			expectedOutstanding: currency.NewRupiah(0, 0),
+			expectedCompleted:   true,
+		},
+		{
+			name:               "Fail - Pay More than Outstanding",
+			paymentAmount:      currency.NewRupiah(110000*50, 1),
+			currentPaymentDate: now.AddDate(0, 0, 2),
+			expectedError:      model.ErrOverpayOutstanding,
+		},
+		{
+			name:               "Fail - Zero Payment",
+			paymentAmount:      currency.NewRupiah(0, 0),
+			currentPaymentDate: now.AddDate(0, 0, 2),
+			expectedError:      model.ErrNonPositivePayment,
+		},
+		{
+			name:               "Fail - Exact Due - Pay All Term",
+			policy:             model.AllocationExactDue,
+			paymentAmount:      currency.NewRupiah(110000*50, 0),
 			currentPaymentDate: now.AddDate(0, 0, 2),
 			expectedError:      model.ErrMismatchPayment,
 		},
 		{
-			name:               "Fail - Missed 1 Payment - Payment is less than needed",
-			paymentMultiplier:  1,
+			name:               "Fail - Exact Due - Missed 1 Payment - Payment is less than needed",
+			policy:             model.AllocationExactDue,
+			paymentAmount:      currency.NewRupiah(110000, 0),
 			currentPaymentDate: now.AddDate(0, 0, (7*model.MISSED_PAYMENT_THRESHOLD)+1), // 8 days
 			expectedError:      model.ErrMismatchPayment,
 		},
 		{
-			name:               "Fail - Missed 1 Payment - Payment is more than needed",
-			paymentMultiplier:  3,
+			name:               "Fail - Exact Due - Missed 1 Payment - Payment is more than needed",
+			policy:             model.AllocationExactDue,
+			paymentAmount:      currency.NewRupiah(110000*3, 0),
 			currentPaymentDate: now.AddDate(0, 0, (7*model.MISSED_PAYMENT_THRESHOLD)+1), // 8 days
 			expectedError:      model.ErrMismatchPayment,
 		},
 		{
-			name:               "Fail - Missed 2 Payments - Flagged as Delinquent",
-			paymentMultiplier:  3,
-			currentPaymentDate: now.AddDate(0, 0, ((7 * (model.MISSED_PAYMENT_THRESHOLD + 1)) + 1)), // 15 days
-			expectedError:      model.ErrPayInDelinquent,
+			name:                "Successful - Missed 2 Payments - Arrears Paid while Delinquent",
+			paymentAmount:       currency.NewRupiah(110000*3, 0),
+			currentPaymentDate:  now.AddDate(0, 0, ((7 * (model.MISSED_PAYMENT_THRESHOLD + 1)) + 1)), // 15 days
+			expectedError:       nil,
+			expectedOutstanding: currency.NewRupiah(5500000-(110000*3), 0),
 		},
 	}
 
@@ -215,10 +446,10 @@ func TestRecordPayment(t *testing.T) {
 			loanService := loan.NewLoanService(memStorage)
 
 			// create a loan first
-			loan, err := loanService.CreateLoan(principal, interestRate, weeklyLoanTerm)
+			loan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: weeklyLoanTerm, RateBasis: model.RatePerTenor, AllocationPolicy: tc.policy})
 			g.Expect(err).ToNot(HaveOccurred())
 
-			err = loanService.RecordPayment(loan.ID, tc.currentPaymentDate, loan.WeeklyPayment.Multiply(tc.paymentMultiplier))
+			err = loanService.RecordPayment(loan.ID, tc.currentPaymentDate, tc.paymentAmount)
 
 			if tc.expectedError != nil {
 				g.Expect(err).To(HaveOccurred())
@@ -230,11 +461,151 @@ func TestRecordPayment(t *testing.T) {
 				g.Expect(err).ToNot(HaveOccurred())
 
 				g.Expect(updatedLoan.OutstandingBalance).To(Equal(tc.expectedOutstanding))
+				g.Expect(updatedLoan.Credit).To(Equal(tc.expectedCredit))
+				g.Expect(updatedLoan.IsCompleted).To(Equal(tc.expectedCompleted))
 			}
 		})
 	}
 }
 
+func TestMakePaymentIdempotency(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{
+		Principal:          currency.NewRupiah(5000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           50,
+		RateBasis:          model.RatePerTenor,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	when := createdLoan.StartDate.AddDate(0, 0, 2)
+	amount := currency.NewRupiah(110000, 0)
+
+	payment, err := loanService.MakePayment(createdLoan.ID, when, amount, "trx-1")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(payment.ID.IsZero()).To(BeFalse())
+	g.Expect(payment.Reference).To(Equal("trx-1"))
+	g.Expect(payment.BalanceAfter).To(Equal(currency.NewRupiah(5500000-110000, 0)))
+
+	t.Run("Replayed", func(t *testing.T) {
+		g := NewWithT(t)
+
+		replayed, err := loanService.MakePayment(createdLoan.ID, when, amount, "trx-1")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(replayed).To(Equal(payment))
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(payment.BalanceAfter))
+		g.Expect(updatedLoan.Payments).To(HaveLen(1))
+	})
+
+	t.Run("Reused for Another Payment", func(t *testing.T) {
+		g := NewWithT(t)
+
+		_, err := loanService.MakePayment(createdLoan.ID, when, amount.Add(amount), "trx-1")
+		g.Expect(err).To(MatchError(model.ErrIdempotencyKeyConflict))
+
+		_, err = loanService.MakePayment(createdLoan.ID, when.Add(time.Hour), amount, "trx-1")
+		g.Expect(err).To(MatchError(model.ErrIdempotencyKeyConflict))
+	})
+
+	t.Run("Without Reference", func(t *testing.T) {
+		g := NewWithT(t)
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, when, amount)).To(Succeed())
+		g.Expect(loanService.RecordPayment(createdLoan.ID, when, amount)).To(Succeed())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(5500000-3*110000, 0)))
+		g.Expect(updatedLoan.Payments).To(HaveLen(3))
+	})
+}
+
+func TestPaymentAllocation(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now().UTC()
+	principal := currency.NewRupiah(1000000, 0)
+	interestRate := model.BPS(1000)
+	loanTermWeekly := 10
+
+	t.Run("Partial Payments Fill the Oldest Billing First", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, now.AddDate(0, 0, 1), currency.NewRupiah(60000, 0))).To(Succeed())
+		g.Expect(loanService.RecordPayment(createdLoan.ID, now.AddDate(0, 0, 2), currency.NewRupiah(60000, 0))).To(Succeed())
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].IsPaid()).To(BeTrue())
+		g.Expect(billings[1].PaidAmount).To(Equal(currency.NewRupiah(10000, 0)))
+		g.Expect(billings[1].Remaining()).To(Equal(currency.NewRupiah(100000, 0)))
+		g.Expect(billings[2].PaidAmount).To(BeZero())
+	})
+
+	t.Run("Waterfall Pays the Interest Before the Principal", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// each installment is 100000 principal + 10000 interest
+		g.Expect(loanService.RecordPayment(createdLoan.ID, now.AddDate(0, 0, 1), currency.NewRupiah(60000, 0))).To(Succeed())
+		g.Expect(loanService.RecordPayment(createdLoan.ID, now.AddDate(0, 0, 2), currency.NewRupiah(60000, 0))).To(Succeed())
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].PaidInterest).To(Equal(currency.NewRupiah(10000, 0)))
+		g.Expect(billings[0].PaidPrincipal).To(Equal(currency.NewRupiah(100000, 0)))
+		g.Expect(billings[1].PaidInterest).To(Equal(currency.NewRupiah(10000, 0)))
+		g.Expect(billings[1].PaidPrincipal).To(BeZero())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.Payments).To(HaveLen(2))
+		g.Expect(updatedLoan.Payments[0].Interest).To(Equal(currency.NewRupiah(10000, 0)))
+		g.Expect(updatedLoan.Payments[0].Principal).To(Equal(currency.NewRupiah(50000, 0)))
+		g.Expect(updatedLoan.Payments[1].Principal).To(Equal(currency.NewRupiah(50000, 0)))
+		g.Expect(updatedLoan.Payments[1].Interest).To(Equal(currency.NewRupiah(10000, 0)))
+	})
+
+	t.Run("Held Credit Covers the Next Due Billings", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor, AllocationPolicy: model.AllocationHoldAsCredit})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, now.AddDate(0, 0, 2), currency.NewRupiah(110000*3, 0))).To(Succeed())
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].IsPaid()).To(BeTrue())
+		g.Expect(billings[1].IsPaid()).To(BeFalse())
+
+		// without the credit, 2 more missed billings would have flagged the loan
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, now.AddDate(0, 0, ((7*(model.MISSED_PAYMENT_THRESHOLD+1))+1)))
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeFalse())
+	})
+
+	t.Run("Unknown Policy", func(t *testing.T) {
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+		_, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor, AllocationPolicy: "pay_whenever"})
+		g.Expect(err).To(Equal(model.ErrUnknownPolicy))
+	})
+}
+
 func TestGetLoan(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -246,7 +617,7 @@ func TestGetLoan(t *testing.T) {
 	principal := currency.NewRupiah(1000000, 0)
 	interestRate := model.BPS(1000)
 	loanTermWeekly := 10
-	createdLoan, err := loanService.CreateLoan(principal, interestRate, loanTermWeekly)
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor})
 	g.Expect(err).ToNot(HaveOccurred())
 
 	randoID, err := typeid.New[model.LoanID]()
@@ -308,7 +679,7 @@ func TestColdDelinquentFlag(t *testing.T) {
 	principal := currency.NewRupiah(1000000, 0)
 	interestRate := model.BPS(1000)
 	loanTermWeekly := 10
-	createdLoan, err := loanService.CreateLoan(principal, interestRate, loanTermWeekly)
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor})
 	g.Expect(err).ToNot(HaveOccurred())
 
 	randoID, err := typeid.New[model.LoanID]()
@@ -371,3 +742,218 @@ func TestColdDelinquentFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBillingSchedule(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	memStorage := memorystorage.NewLoanMemoryStorage()
+	loanService := loan.NewLoanService(memStorage)
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: currency.NewRupiah(1000000, 0), AnnualInterestRate: model.BPS(1000), LoanTerm: 10, RateBasis: model.RatePerTenor})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(billings).To(HaveLen(10))
+
+	for i, b := range billings {
+		g.Expect(b.TermNumber).To(Equal(i + 1))
+		g.Expect(b.PaymentDueDate).To(Equal(createdLoan.StartDate.AddDate(0, 0, 7*(i+1))))
+		g.Expect(b.Repayment).To(Equal(createdLoan.InstallmentAmount))
+		g.Expect(b.IsPaid()).To(BeFalse())
+	}
+
+	randoID, err := typeid.New[model.LoanID]()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = loanService.GetBillingSchedule(randoID)
+	g.Expect(err).To(Equal(model.ErrLoanNotFound))
+}
+
+func TestQuotePayoff(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	now := time.Now().UTC()
+	principal := currency.NewRupiah(1000000, 0)
+	interestRate := model.BPS(1000)
+	loanTermWeekly := 10
+
+	testCases := []struct {
+		name                   string
+		rebateRule             model.RebateRule
+		policy                 model.AllocationPolicy
+		paymentBeforeQuote     currency.Rupiah
+		deferredPeriods        int
+		quoteDate              time.Time
+		expectedRemainingTerms int
+		expectedRebate         currency.Rupiah
+		expectedPayoff         currency.Rupiah
+	}{
+		{
+			name:                   "No Rebate",
+			rebateRule:             model.RebateNone,
+			quoteDate:              now.AddDate(0, 0, 2),
+			expectedRemainingTerms: 9,
+			expectedRebate:         currency.NewRupiah(0, 0),
+			expectedPayoff:         currency.NewRupiah(1100000, 0),
+		},
+		{
+			name:                   "Pro Rata - First Term",
+			rebateRule:             model.RebateProRata,
+			quoteDate:              now.AddDate(0, 0, 2),
+			expectedRemainingTerms: 9,
+			expectedRebate:         currency.NewRupiah(90000, 0),
+			expectedPayoff:         currency.NewRupiah(1010000, 0),
+		},
+		{
+			name:                   "Pro Rata - Fourth Term",
+			rebateRule:             model.RebateProRata,
+			quoteDate:              now.AddDate(0, 0, 22),
+			expectedRemainingTerms: 6,
+			expectedRebate:         currency.NewRupiah(60000, 0),
+			expectedPayoff:         currency.NewRupiah(1040000, 0),
+		},
+		{
+			name:                   "Rule of 78 - First Term",
+			rebateRule:             model.RebateRuleOf78,
+			quoteDate:              now.AddDate(0, 0, 2),
+			expectedRemainingTerms: 9,
+			expectedRebate:         currency.NewRupiah(81818, 18), // 100000 * 45/55
+			expectedPayoff:         currency.NewRupiah(1018181, 82),
+		},
+		{
+			name:                   "Rule of 78 - Deferred",
+			rebateRule:             model.RebateRuleOf78,
+			deferredPeriods:        2,
+			quoteDate:              now.AddDate(0, 0, 2),
+			expectedRemainingTerms: 10,
+			expectedRebate:         currency.NewRupiah(100000, 0), // 100000 * 55/55, the first two terms carry no interest
+			expectedPayoff:         currency.NewRupiah(1000000, 0),
+		},
+		{
+			name:                   "Pro Rata - Credit is Deducted",
+			rebateRule:             model.RebateProRata,
+			policy:                 model.AllocationHoldAsCredit,
+			paymentBeforeQuote:     currency.NewRupiah(330000, 0),
+			quoteDate:              now.AddDate(0, 0, 2),
+			expectedRemainingTerms: 9,
+			expectedRebate:         currency.NewRupiah(90000, 0),
+			expectedPayoff:         currency.NewRupiah(990000-220000-90000, 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithRebateRule(tc.rebateRule))
+
+			createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: principal, AnnualInterestRate: interestRate, LoanTerm: loanTermWeekly, RateBasis: model.RatePerTenor, AllocationPolicy: tc.policy})
+			g.Expect(err).ToNot(HaveOccurred())
+
+			if tc.paymentBeforeQuote > 0 {
+				g.Expect(loanService.RecordPayment(createdLoan.ID, tc.quoteDate, tc.paymentBeforeQuote)).To(Succeed())
+			}
+
+			if tc.deferredPeriods > 0 {
+				_, err = loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{Periods: tc.deferredPeriods, Method: model.DeferralExtendTenor})
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+
+			quote, err := loanService.QuotePayoff(createdLoan.ID, tc.quoteDate)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(quote.RebateRule).To(Equal(tc.rebateRule))
+			g.Expect(quote.RemainingTerms).To(Equal(tc.expectedRemainingTerms))
+			g.Expect(quote.InterestRebate).To(Equal(tc.expectedRebate))
+			g.Expect(quote.PayoffAmount).To(Equal(tc.expectedPayoff))
+		})
+	}
+}
+
+func TestSettleLoan(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{Principal: currency.NewRupiah(1000000, 0), AnnualInterestRate: model.BPS(1000), LoanTerm: 10, RateBasis: model.RatePerTenor})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	settleAt := createdLoan.StartDate.AddDate(0, 0, 2)
+	quote, err := loanService.QuotePayoff(createdLoan.ID, settleAt)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	_, err = loanService.SettleLoan(createdLoan.ID, settleAt, quote.PayoffAmount.Subtract(currency.NewRupiah(1, 0)))
+	g.Expect(err).To(Equal(model.ErrMismatchPayoff))
+
+	payment, err := loanService.SettleLoan(createdLoan.ID, settleAt, quote.PayoffAmount)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	settled, err := loanService.GetLoan(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(settled.IsCompleted).To(BeTrue())
+	g.Expect(settled.OutstandingBalance).To(BeZero())
+	g.Expect(settled.Payments).To(HaveLen(1))
+	g.Expect(settled.Payments[0]).To(Equal(payment))
+	g.Expect(payment.Amount).To(Equal(quote.PayoffAmount))
+
+	// only the interest of the running term is collected, the rest is rebated
+	g.Expect(payment.Principal).To(Equal(currency.NewRupiah(1000000, 0)))
+	g.Expect(payment.Interest).To(Equal(currency.NewRupiah(10000, 0)))
+	g.Expect(payment.Rebate).To(Equal(quote.InterestRebate))
+	g.Expect(payment.Applied()).To(Equal(payment.Amount))
+
+	// the billings are paid up to the money received, the rebate covers the rest
+	billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	var paid, rebate currency.Rupiah
+	for _, b := range billings {
+		g.Expect(b.IsPaid()).To(BeTrue())
+		paid = paid.Add(b.PaidAmount)
+		rebate = rebate.Add(b.Rebate)
+	}
+	g.Expect(paid).To(Equal(payment.Amount))
+	g.Expect(rebate).To(Equal(quote.InterestRebate))
+
+	_, err = loanService.SettleLoan(createdLoan.ID, settleAt, quote.PayoffAmount)
+	g.Expect(err).To(Equal(model.ErrRepaymentComplete))
+
+	_, err = loanService.QuotePayoff(createdLoan.ID, settleAt)
+	g.Expect(err).To(Equal(model.ErrRepaymentComplete))
+}
+
+func TestMonthlyLoan(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage())
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{
+		Principal:          currency.NewRupiah(1200000, 0),
+		AnnualInterestRate: model.BPS(1200),
+		Frequency:          model.FrequencyMonthly,
+		LoanTerm:           12,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(createdLoan.InstallmentAmount).To(Equal(currency.NewRupiah(112000, 0)))
+
+	billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(billings).To(HaveLen(12))
+
+	for i, b := range billings {
+		g.Expect(b.PaymentDueDate).To(Equal(model.FrequencyMonthly.DueDate(createdLoan.StartDate, i+1)))
+	}
+
+	// the threshold counts installments, not weeks
+	start := createdLoan.StartDate
+	secondMonth := model.FrequencyMonthly.DueDate(start, 1)
+
+	isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, secondMonth.AddDate(0, 0, 20))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeFalse())
+
+	isDelinquent, err = loanService.CheckDelinquency(createdLoan.ID, model.FrequencyMonthly.DueDate(start, 2).Add(time.Hour))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeTrue())
+}
@@ -0,0 +1,246 @@
+package loan
+
+import (
+	"errors"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"go.jetify.com/typeid"
+)
+
+// RecordPayment records a loan payment without a reference, a retry is recorded again (see `MakePayment`)
+func (ls *LoanService) RecordPayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) error {
+	_, err := ls.MakePayment(loanID, when, paymentAmount, "")
+
+	return err
+}
+
+// MakePayment records a loan payment [idempotent operation by `reference`]: a payment replayed with the same reference
+// returns the payment recorded the first time, the reference reused for another amount or time fails with
+// `model.ErrIdempotencyKeyConflict`. An empty reference is recorded every time. A payment of a written-off loan is
+// recorded as a recovery (see `WriteOffLoan`)
+func (ls *LoanService) MakePayment(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah, reference string) (model.Payment, error) {
+	when = when.UTC() // make sure, as this service data is in UTC
+
+	var payment model.Payment
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		payment, err = ls.recordPayment(tx, loanID, when, paymentAmount, reference)
+
+		return err
+	})
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (ls *LoanService) recordPayment(tx ports.LoanStorage, loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah, reference string) (model.Payment, error) {
+	// the late charges up to the payment are required as well, a replay has nothing left to accrue
+	_, err := accrueLateCharges(tx, loanID, when, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	// locks the loan, a replay racing the original waits for it
+	loan, err := tx.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	if reference != "" {
+		recorded, err := tx.GetPaymentByReference(loanID, reference)
+		if err == nil {
+			return replayPayment(recorded, when, paymentAmount)
+		}
+		if !errors.Is(err, model.ErrPaymentNotFound) {
+			return model.Payment{}, err
+		}
+	}
+
+	// validation
+	if loan.IsCompleted {
+		return model.Payment{}, model.ErrRepaymentComplete
+	}
+
+	if !(paymentAmount > 0) {
+		return model.Payment{}, model.ErrNonPositivePayment
+	}
+
+	if paymentAmount.Add(loan.Credit) > loan.OutstandingBalance {
+		return model.Payment{}, model.ErrOverpayOutstanding
+	}
+
+	if loan.IsWrittenOff() {
+		return recordRecovery(tx, loan, when, paymentAmount, reference)
+	}
+
+	// due dillligence check, a delinquent loan still takes the payments of its arrears
+	isDelinquent, unfulfilledBilling, err := coldDelinquentFlag(tx, loanID, when)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	delinquencyUpdateParams := loan.DelinquencyStatus
+
+	missed := missedBillings(loan.DelinquencyRule, unfulfilledBilling, when)
+
+	err = transitionDelinquency(tx, loanID, &delinquencyUpdateParams, loan.IsDelinquent || isDelinquent, missed, when)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	if loan.AllocationPolicy == model.AllocationExactDue {
+		amountNeeded := currency.NewRupiah(0, 0)
+		for _, billing := range unfulfilledBilling {
+			amountNeeded = amountNeeded.Add(billing.Remaining())
+		}
+
+		// payment has to be exact with the installment multiplier, the arrears can be paid bit by bit
+		arrears := delinquencyUpdateParams.IsDelinquent && paymentAmount < amountNeeded
+		if amountNeeded != paymentAmount && !arrears {
+			return model.Payment{}, model.ErrMismatchPayment
+		}
+	}
+
+	billings, err := tx.GetBillings(loanID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	paymentID, err := typeid.New[model.PaymentID]()
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	payment := model.Payment{
+		ID:            paymentID,
+		LoanID:        loanID,
+		Kind:          model.PaymentRepayment,
+		Reference:     reference,
+		Date:          when,
+		Amount:        paymentAmount,
+		BalanceBefore: loan.OutstandingBalance,
+	}
+
+	// credit is drawn together with the payment, the excess is held again if the policy says so
+	available := paymentAmount.Add(loan.Credit)
+	currentTerm := loan.CurrentTerm(when) // same as the unfulfilled billing
+
+	paidBillings, rest := allocate(billings, available, &payment, func(b model.Billing) bool {
+		return b.TermNumber <= currentTerm
+	})
+
+	if rest > 0 && loan.AllocationPolicy != model.AllocationHoldAsCredit {
+		var paidFuture []model.Billing
+		paidFuture, rest = allocate(billings, rest, &payment, func(model.Billing) bool { return true })
+		paidBillings = append(paidBillings, paidFuture...)
+	}
+
+	applied := available.Subtract(rest)
+
+	loanUpdateParams := loan.InstallmentLoan
+	loanUpdateParams.Credit = rest
+	loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Subtract(applied)
+	if loanUpdateParams.OutstandingBalance <= 0 {
+		loanUpdateParams.OutstandingBalance = currency.NewRupiah(0, 0)
+		loanUpdateParams.IsCompleted = true
+	}
+
+	// paying the arrears back under the threshold cures the loan
+	missed = missedBillings(loan.DelinquencyRule, billings, when)
+
+	err = transitionDelinquency(tx, loanID, &delinquencyUpdateParams, missed > loan.DelinquencyRule.MissedPaymentThreshold, missed, when)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	payment.BalanceAfter = loanUpdateParams.OutstandingBalance
+
+	err = tx.RecordPayment(loanID, payment)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateLoan(loanID, loanUpdateParams)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateBillings(loanID, paidBillings)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+// replayPayment returns the payment recorded with the same reference, as long as it is the same payment. Postgres only
+// keeps the microseconds of the time
+func replayPayment(recorded model.Payment, when time.Time, paymentAmount currency.Rupiah) (model.Payment, error) {
+	if recorded.Amount != paymentAmount || !recorded.Date.Truncate(time.Microsecond).Equal(when.Truncate(time.Microsecond)) {
+		return model.Payment{}, model.ErrIdempotencyKeyConflict
+	}
+
+	return recorded, nil
+}
+
+// allocate applies `amount` to the unpaid billings matching `eligible`, oldest first, following the `waterfall`.
+// `billings` is updated in place and what has been paid per component is added to `payment`, the touched billings and
+// the unallocated rest are returned
+func allocate(billings []model.Billing, amount currency.Rupiah, payment *model.Payment, eligible func(model.Billing) bool) ([]model.Billing, currency.Rupiah) {
+	var touched []model.Billing
+
+	for i := range billings {
+		if !(amount > 0) {
+			break
+		}
+
+		if billings[i].IsPaid() || !eligible(billings[i]) {
+			continue
+		}
+
+		amount = waterfall(&billings[i], amount, payment)
+
+		touched = append(touched, billings[i])
+	}
+
+	return touched, amount
+}
+
+// waterfall pays a single billing component by component: penalty, then fee, then interest and the principal last,
+// so the principal is only reduced once the charges of the term are settled. The unallocated rest is returned
+func waterfall(billing *model.Billing, amount currency.Rupiah, payment *model.Payment) currency.Rupiah {
+	components := []struct {
+		due       currency.Rupiah
+		paid      *currency.Rupiah
+		collected *currency.Rupiah
+	}{
+		{billing.Penalty, &billing.PaidPenalty, &payment.Penalty},
+		{billing.Fee, &billing.PaidFee, &payment.Fee},
+		{billing.Interest, &billing.PaidInterest, &payment.Interest},
+		{billing.Principal, &billing.PaidPrincipal, &payment.Principal},
+	}
+
+	for _, c := range components {
+		pay := min(amount, c.due.Subtract(*c.paid))
+		if !(pay > 0) {
+			continue
+		}
+
+		*c.paid = c.paid.Add(pay)
+		*c.collected = c.collected.Add(pay)
+		billing.PaidAmount = billing.PaidAmount.Add(pay)
+		amount = amount.Subtract(pay)
+	}
+
+	return amount
+}
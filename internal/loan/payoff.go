@@ -0,0 +1,227 @@
+package loan
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"github.com/shopspring/decimal"
+	"go.jetify.com/typeid"
+)
+
+// QuotePayoff tells how much is needed to close the loan at `at`
+func (ls *LoanService) QuotePayoff(loanID model.LoanID, at time.Time) (model.PayoffQuote, error) {
+	at = at.UTC()
+
+	loan, err := ls.storage.GetLoan(loanID)
+	if err != nil {
+		return model.PayoffQuote{}, err
+	}
+
+	if loan.IsCompleted {
+		return model.PayoffQuote{}, model.ErrRepaymentComplete
+	}
+
+	// what is collected of a written-off loan is a recovery, see `MakePayment`
+	if loan.IsWrittenOff() {
+		return model.PayoffQuote{}, model.ErrLoanWrittenOff
+	}
+
+	billings, err := ls.storage.GetBillings(loanID)
+	if err != nil {
+		return model.PayoffQuote{}, err
+	}
+
+	// the late charges up to the quote are owed as well, they are persisted once the loan is settled
+	_, charged := chargeLate(billings, chargedUntil(at, ls.clock.Now()), ls.lateFeePolicy, ls.rounding)
+	loan.OutstandingBalance = loan.OutstandingBalance.Add(charged)
+
+	return payoffQuote(loan, billings, at, ls.rebateRule, ls.rounding), nil
+}
+
+// SettleLoan closes the loan early, `paymentAmount` has to be exactly the payoff amount quoted at `when`. The settlement
+// is recorded as a payment carrying the rebate, it can be reversed like any other payment
+func (ls *LoanService) SettleLoan(loanID model.LoanID, when time.Time, paymentAmount currency.Rupiah) (model.Payment, error) {
+	when = when.UTC()
+
+	var payment model.Payment
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		_, err := accrueLateCharges(tx, loanID, when, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+		if err != nil {
+			return err
+		}
+
+		loan, err := tx.GetLoanWithDelinquency(loanID)
+		if err != nil {
+			return err
+		}
+
+		if loan.IsCompleted {
+			return model.ErrRepaymentComplete
+		}
+
+		if loan.IsWrittenOff() {
+			return model.ErrLoanWrittenOff
+		}
+
+		billings, err := tx.GetBillings(loanID)
+		if err != nil {
+			return err
+		}
+
+		quote := payoffQuote(loan.InstallmentLoan, billings, when, ls.rebateRule, ls.rounding)
+		if paymentAmount != quote.PayoffAmount {
+			return model.ErrMismatchPayoff
+		}
+
+		paymentID, err := typeid.New[model.PaymentID]()
+		if err != nil {
+			return err
+		}
+
+		payment = model.Payment{
+			ID:            paymentID,
+			LoanID:        loanID,
+			Kind:          model.PaymentRepayment,
+			Date:          when,
+			Amount:        paymentAmount,
+			BalanceBefore: loan.OutstandingBalance,
+			BalanceAfter:  currency.NewRupiah(0, 0),
+			Rebate:        quote.InterestRebate,
+		}
+
+		settled := settle(billings, quote.InterestRebate, &payment)
+
+		err = tx.RecordPayment(loanID, payment)
+		if err != nil {
+			return err
+		}
+
+		err = tx.UpdateBillings(loanID, settled)
+		if err != nil {
+			return err
+		}
+
+		// nothing is left in arrears
+		delinquencyUpdateParams := loan.DelinquencyStatus
+
+		err = transitionDelinquency(tx, loanID, &delinquencyUpdateParams, false, 0, when)
+		if err != nil {
+			return err
+		}
+
+		err = tx.UpdateDelinquencyStatus(loanID, delinquencyUpdateParams)
+		if err != nil {
+			return err
+		}
+
+		loanUpdateParams := loan.InstallmentLoan
+		loanUpdateParams.OutstandingBalance = currency.NewRupiah(0, 0)
+		loanUpdateParams.Credit = currency.NewRupiah(0, 0)
+		loanUpdateParams.IsCompleted = true
+
+		return tx.UpdateLoan(loanID, loanUpdateParams)
+	})
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+// settle closes every unpaid billing: `rebate` is given back from the latest billings first, their unpaid interest and
+// then their unpaid principal when the interest has been paid in advance, the payment pays the rest. The rebate is
+// kept on the billing apart from what has been paid. `billings` is updated in place, the settled billings are
+// returned
+func settle(billings []model.Billing, rebate currency.Rupiah, payment *model.Payment) []model.Billing {
+	rebatedInterest := make([]currency.Rupiah, len(billings))
+	for i := len(billings) - 1; i >= 0; i-- {
+		if billings[i].IsPaid() {
+			continue
+		}
+
+		rebatedInterest[i] = min(rebate, billings[i].Interest.Subtract(billings[i].PaidInterest))
+		rebate = rebate.Subtract(rebatedInterest[i])
+	}
+
+	rebatedPrincipal := make([]currency.Rupiah, len(billings))
+	for i := len(billings) - 1; i >= 0; i-- {
+		if billings[i].IsPaid() {
+			continue
+		}
+
+		rebatedPrincipal[i] = min(rebate, billings[i].Principal.Subtract(billings[i].PaidPrincipal))
+		rebate = rebate.Subtract(rebatedPrincipal[i])
+	}
+
+	var settled []model.Billing
+	for i := range billings {
+		b := &billings[i]
+		if b.IsPaid() {
+			continue
+		}
+
+		// the payment only pays what is not rebated
+		b.Rebate = rebatedInterest[i].Add(rebatedPrincipal[i])
+		b.Interest = b.Interest.Subtract(rebatedInterest[i])
+		b.Principal = b.Principal.Subtract(rebatedPrincipal[i])
+		b.Repayment = b.Repayment.Subtract(b.Rebate)
+
+		waterfall(b, b.Repayment.Subtract(b.PaidAmount), payment)
+
+		b.Interest = b.Interest.Add(rebatedInterest[i])
+		b.Principal = b.Principal.Add(rebatedPrincipal[i])
+		b.Repayment = b.Repayment.Add(b.Rebate)
+
+		settled = append(settled, *b)
+	}
+
+	return settled
+}
+
+func payoffQuote(loan model.InstallmentLoan, billings []model.Billing, at time.Time, rule model.RebateRule, rounding model.RoundingMode) model.PayoffQuote {
+	// the interest of a term is earned once the term has started, the same as the due billings
+	currentTerm := loan.CurrentTerm(at)
+
+	// a deferred installment carries no interest anymore, it is not a term of the rebate
+	terms := 0
+	remainingTerms := 0
+	unearnedInterest := currency.NewRupiah(0, 0)
+	for _, b := range billings {
+		if !b.DeferredAt.IsZero() {
+			continue
+		}
+
+		terms++
+		if b.TermNumber > currentTerm {
+			remainingTerms++
+			unearnedInterest = unearnedInterest.Add(b.Interest)
+		}
+	}
+
+	rebate := currency.NewRupiah(0, 0)
+	switch rule {
+	case model.RebateProRata:
+		rebate = unearnedInterest
+	case model.RebateRuleOf78:
+		n := terms
+		weight := decimal.NewFromInt(int64(remainingTerms * (remainingTerms + 1))).Div(decimal.NewFromInt(int64(n * (n + 1))))
+		rebate = toRupiah(sen(loan.TotalInterest).Mul(weight), rounding)
+	}
+
+	// installments paid in advance already carry their interest, the rebate can't turn into a refund
+	owed := loan.OutstandingBalance.Subtract(loan.Credit)
+	rebate = min(rebate, owed)
+
+	return model.PayoffQuote{
+		LoanID:             loan.ID,
+		QuoteDate:          at,
+		OutstandingBalance: loan.OutstandingBalance,
+		Credit:             loan.Credit,
+		RemainingTerms:     remainingTerms,
+		RebateRule:         rule,
+		InterestRebate:     rebate,
+		PayoffAmount:       owed.Subtract(rebate),
+	}
+}
@@ -0,0 +1,35 @@
+package loan
+
+import (
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/shopspring/decimal"
+)
+
+// TermRates is the interest rate charged for every term of the loan:
+//   - `RateAnnual`: the annual rate times the fraction of the year the term spans by the day count
+//   - `RateMonthly`: the same, with 12 times the monthly rate as the annual rate
+//   - `RatePerTenor`: the rate is spread evenly over the terms, the day count doesn't matter
+func TermRates(loan model.InstallmentLoan) []decimal.Decimal {
+	rates := make([]decimal.Decimal, 0, loan.LoanTerm)
+
+	if loan.RateBasis == model.RatePerTenor {
+		rate := loan.AnnualInterestRate.Fraction().Div(decimal.NewFromInt(int64(loan.LoanTerm)))
+		for range loan.LoanTerm {
+			rates = append(rates, rate)
+		}
+
+		return rates
+	}
+
+	annualRate := loan.AnnualInterestRate.Fraction()
+	if loan.RateBasis == model.RateMonthly {
+		annualRate = annualRate.Mul(decimal.NewFromInt(12))
+	}
+
+	for term := 1; term <= loan.LoanTerm; term++ {
+		yearFraction := loan.DayCount.YearFraction(loan.DueDate(term-1), loan.DueDate(term))
+		rates = append(rates, annualRate.Mul(yearFraction))
+	}
+
+	return rates
+}
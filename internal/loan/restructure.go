@@ -0,0 +1,178 @@
+package loan
+
+import (
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// RestructureLoan reschedules what is left of the loan from `param.When` over a new term count and rate. The principal
+// left is rescheduled, the unearned interest of the old schedule is dropped and the arrears (the earned interest, the
+// fees and the penalties left unpaid) are either capitalized or due with the first new installment. The unpaid
+// billings of the old schedule are superseded and the terms get a new version, the old one is kept (see
+// `GetTermsHistory`)
+func (ls *LoanService) RestructureLoan(loanID model.LoanID, param model.RestructureParam) (model.InstallmentLoan, error) {
+	now := ls.clock.Now().UTC()
+
+	when := param.When.UTC()
+	if param.When.IsZero() {
+		when = now
+	}
+
+	// validation, tiger style
+	if !(param.AnnualInterestRate >= 0) {
+		return model.InstallmentLoan{}, model.ErrNegativeInterest
+	}
+
+	if !(param.LoanTerm > 0) {
+		return model.InstallmentLoan{}, model.ErrNoTerm
+	}
+
+	var restructured model.InstallmentLoan
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		// the late charges up to the restructure are part of the arrears
+		_, err := accrueLateCharges(tx, loanID, when, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+		if err != nil {
+			return err
+		}
+
+		loan, err := tx.GetLoanWithDelinquency(loanID)
+		if err != nil {
+			return err
+		}
+
+		if loan.IsCompleted {
+			return model.ErrRepaymentComplete
+		}
+
+		if loan.IsWrittenOff() {
+			return model.ErrLoanWrittenOff
+		}
+
+		if when.Before(loan.StartDate) || when.After(now) {
+			return model.ErrInvalidRestructureDate
+		}
+
+		frequency := param.Frequency
+		if frequency == "" {
+			frequency = loan.Frequency
+		}
+
+		if !frequency.IsValid() {
+			return model.ErrUnknownFrequency
+		}
+
+		if !param.FirstDueDate.IsZero() && !frequency.IsValidFirstDueDate(when, param.FirstDueDate.UTC()) {
+			return model.ErrInvalidFirstDueDate
+		}
+
+		interestCalculator, ok := ls.interestCalculators[loan.InterestMethod]
+		if !ok {
+			return model.ErrUnknownInterestMethod
+		}
+
+		billings, err := tx.GetBillings(loanID)
+		if err != nil {
+			return err
+		}
+
+		// the interest of a term is earned once the term has started, the same as the payoff quote
+		currentTerm := loan.CurrentTerm(when)
+
+		var principal, interest, fee, penalty currency.Rupiah
+		for _, b := range billings {
+			if b.IsPaid() {
+				continue
+			}
+
+			principal = principal.Add(b.Principal.Subtract(b.PaidPrincipal))
+			fee = fee.Add(b.Fee.Subtract(b.PaidFee))
+			penalty = penalty.Add(b.Penalty.Subtract(b.PaidPenalty))
+			if b.TermNumber <= currentTerm {
+				interest = interest.Add(b.Interest.Subtract(b.PaidInterest))
+			}
+		}
+
+		arrears := interest.Add(fee).Add(penalty)
+		if param.CapitalizeArrears {
+			principal = principal.Add(arrears)
+		}
+
+		restructured = loan.InstallmentLoan
+		restructured.Principal = principal
+		restructured.AnnualInterestRate = param.AnnualInterestRate
+		restructured.StartDate = when
+		restructured.Frequency = frequency
+		restructured.FirstDueDate = param.FirstDueDate.UTC()
+		restructured.LoanTerm = param.LoanTerm
+		restructured.TermsVersion = loan.TermsVersion + 1
+
+		split := interestCalculator.Split(principal, TermRates(restructured), ls.rounding)
+		if !param.CapitalizeArrears {
+			split[0].Interest = split[0].Interest.Add(interest)
+			split[0].Fee = split[0].Fee.Add(fee)
+			split[0].Penalty = split[0].Penalty.Add(penalty)
+			split[0].Repayment = split[0].Repayment.Add(arrears)
+		}
+
+		totalInterest := currency.NewRupiah(0, 0)
+		outstanding := currency.NewRupiah(0, 0)
+		for _, b := range split {
+			totalInterest = totalInterest.Add(b.Interest)
+			outstanding = outstanding.Add(b.Repayment)
+		}
+
+		restructured.TotalInterest = totalInterest
+		restructured.OutstandingBalance = outstanding
+		restructured.InstallmentAmount = split[0].Repayment
+		restructured.InstallmentInterest = split[0].Interest
+
+		superseded := loan.Terms()
+		superseded.SupersededAt = when
+
+		err = tx.RecordLoanTerms(loanID, superseded)
+		if err != nil {
+			return err
+		}
+
+		err = tx.SupersedeBillings(loanID, loan.TermsVersion, when)
+		if err != nil {
+			return err
+		}
+
+		err = tx.UpdateLoan(loanID, restructured)
+		if err != nil {
+			return err
+		}
+
+		err = tx.CreateBillings(loanID, billingSchedule(restructured, split))
+		if err != nil {
+			return err
+		}
+
+		// nothing of the new schedule is missed yet, a delinquent loan is cured
+		_, err = checkDelinquency(tx, loanID, when, now)
+
+		return err
+	})
+	if err != nil {
+		return model.InstallmentLoan{}, err
+	}
+
+	return restructured, nil
+}
+
+// GetTermsHistory lists every version of the terms of the loan, the current one last
+func (ls *LoanService) GetTermsHistory(loanID model.LoanID) ([]model.LoanTerms, error) {
+	loan, err := ls.storage.GetLoan(loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	superseded, err := ls.storage.GetLoanTerms(loanID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(superseded, loan.Terms()), nil
+}
@@ -0,0 +1,153 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+func TestRestructureLoan(t *testing.T) {
+	t.Parallel()
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	}
+
+	// the first installment is paid, the second and the third are missed and the fourth has started at day 22
+	setup := func(g *WithT) (*loan.LoanService, *clocktest.Clock, model.InstallmentLoan, model.Payment) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(110000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(22)
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		return loanService, clock, createdLoan, payment
+	}
+
+	t.Run("Capitalizes the Arrears", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, _ := setup(g)
+
+		// 900000 principal left + 30000 interest of the second to the fourth terms, at 5% per tenor
+		restructured, err := loanService.RestructureLoan(createdLoan.ID, model.RestructureParam{
+			LoanTerm:           5,
+			AnnualInterestRate: model.BPS(500),
+			CapitalizeArrears:  true,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restructured.TermsVersion).To(Equal(2))
+		g.Expect(restructured.StartDate).To(Equal(clock.Now()))
+		g.Expect(restructured.Principal).To(Equal(currency.NewRupiah(930000, 0)))
+		g.Expect(restructured.TotalInterest).To(Equal(currency.NewRupiah(46500, 0)))
+		g.Expect(restructured.OutstandingBalance).To(Equal(currency.NewRupiah(976500, 0)))
+		g.Expect(restructured.InstallmentAmount).To(Equal(currency.NewRupiah(195300, 0)))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings).To(HaveLen(5))
+		g.Expect(billings[0].TermsVersion).To(Equal(2))
+		g.Expect(billings[0].PaymentDueDate).To(Equal(clock.Now().AddDate(0, 0, 7)))
+		g.Expect(billings[0].Repayment).To(Equal(currency.NewRupiah(195300, 0)))
+
+		// nothing of the new schedule is missed
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+		g.Expect(updatedLoan.CuredAt).To(Equal(clock.Now()))
+
+		clock.AdvanceDays(7)
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(195300, 0))).To(Succeed())
+
+		updatedLoan, err = loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(976500-195300, 0)))
+	})
+
+	t.Run("Carries the Arrears", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, _, createdLoan, _ := setup(g)
+
+		restructured, err := loanService.RestructureLoan(createdLoan.ID, model.RestructureParam{
+			LoanTerm:           5,
+			AnnualInterestRate: model.BPS(500),
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restructured.Principal).To(Equal(currency.NewRupiah(900000, 0)))
+		g.Expect(restructured.TotalInterest).To(Equal(currency.NewRupiah(45000+30000, 0)))
+		g.Expect(restructured.OutstandingBalance).To(Equal(currency.NewRupiah(945000+30000, 0)))
+
+		// the arrears are due with the first installment
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].Interest).To(Equal(currency.NewRupiah(9000+30000, 0)))
+		g.Expect(billings[0].Repayment).To(Equal(currency.NewRupiah(189000+30000, 0)))
+		g.Expect(billings[1].Repayment).To(Equal(currency.NewRupiah(189000, 0)))
+	})
+
+	t.Run("Terms History", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, payment := setup(g)
+
+		_, err := loanService.RestructureLoan(createdLoan.ID, model.RestructureParam{
+			LoanTerm:           5,
+			AnnualInterestRate: model.BPS(500),
+			Frequency:          model.FrequencyMonthly,
+		})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		history, err := loanService.GetTermsHistory(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(2))
+		g.Expect(history[0].Version).To(Equal(1))
+		g.Expect(history[0].SupersededAt).To(Equal(clock.Now()))
+		g.Expect(history[0].Principal).To(Equal(currency.NewRupiah(1000000, 0)))
+		g.Expect(history[0].Frequency).To(Equal(model.FrequencyWeekly))
+		g.Expect(history[1].Version).To(Equal(2))
+		g.Expect(history[1].SupersededAt).To(BeZero())
+		g.Expect(history[1].Frequency).To(Equal(model.FrequencyMonthly))
+
+		// the billings it paid are not on the schedule anymore
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).To(MatchError(model.ErrReversalAcrossRestructure))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, _ := setup(g)
+
+		restructure := func(param model.RestructureParam) error {
+			_, err := loanService.RestructureLoan(createdLoan.ID, param)
+			return err
+		}
+
+		g.Expect(restructure(model.RestructureParam{AnnualInterestRate: model.BPS(500)})).To(MatchError(model.ErrNoTerm))
+		g.Expect(restructure(model.RestructureParam{LoanTerm: 5, AnnualInterestRate: model.BPS(-1)})).To(MatchError(model.ErrNegativeInterest))
+		g.Expect(restructure(model.RestructureParam{LoanTerm: 5, When: createdLoan.StartDate.Add(-time.Second)})).To(MatchError(model.ErrInvalidRestructureDate))
+		g.Expect(restructure(model.RestructureParam{LoanTerm: 5, When: clock.Now().Add(time.Second)})).To(MatchError(model.ErrInvalidRestructureDate))
+		g.Expect(restructure(model.RestructureParam{LoanTerm: 5, FirstDueDate: clock.Now()})).To(MatchError(model.ErrInvalidFirstDueDate))
+
+		quote, err := loanService.QuotePayoff(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = loanService.SettleLoan(createdLoan.ID, clock.Now(), quote.PayoffAmount)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(restructure(model.RestructureParam{LoanTerm: 5})).To(MatchError(model.ErrRepaymentComplete))
+	})
+}
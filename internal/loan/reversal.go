@@ -0,0 +1,177 @@
+package loan
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// ReversePayment takes a payment back at `when` (a bounced transfer, a chargeback): the billings it paid are reopened,
+// the outstanding balance and the credit are restored and the delinquency is evaluated again at `when`. The payment is
+// kept for the audit, marked as reversed with `reason`
+func (ls *LoanService) ReversePayment(loanID model.LoanID, paymentID model.PaymentID, when time.Time, reason string) (model.Payment, error) {
+	when = when.UTC()
+
+	var payment model.Payment
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		var err error
+		payment, err = ls.reversePayment(tx, loanID, paymentID, when, reason)
+
+		return err
+	})
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+func (ls *LoanService) reversePayment(tx ports.LoanStorage, loanID model.LoanID, paymentID model.PaymentID, when time.Time, reason string) (model.Payment, error) {
+	if strings.TrimSpace(reason) == "" {
+		return model.Payment{}, model.ErrNoReversalReason
+	}
+
+	// locks the loan, a reversal racing a payment waits for it
+	loan, err := tx.GetLoanWithDelinquency(loanID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	payment, err := tx.GetPayment(loanID, paymentID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	if payment.IsReversed() {
+		return model.Payment{}, model.ErrPaymentReversed
+	}
+
+	if when.Before(payment.Date) {
+		return model.Payment{}, model.ErrReversalBeforePayment
+	}
+
+	// what has been written off stays, only a recovery can be taken back
+	if loan.IsWrittenOff() && payment.Kind != model.PaymentRecovery {
+		return model.Payment{}, model.ErrLoanWrittenOff
+	}
+
+	// the billings it paid have been superseded
+	if loan.TermsVersion > 1 && payment.Date.Before(loan.StartDate) {
+		return model.Payment{}, model.ErrReversalAcrossRestructure
+	}
+
+	billings, err := tx.GetBillings(loanID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	// what the payment took off the balance, a settlement took the rebate off as well
+	restored := payment.BalanceBefore.Subtract(payment.BalanceAfter)
+
+	// what the payment held as credit is taken back, the credit it drew is given back
+	credit := loan.Credit.Subtract(payment.Amount.Subtract(payment.Applied()))
+	if credit < 0 {
+		// the credit has been drawn by a later payment, the billings it paid are reopened too
+		restored = restored.Subtract(credit)
+		credit = currency.NewRupiah(0, 0)
+	}
+
+	// the rebate of a settlement is not paid, it is only taken off the billings
+	unrebated := unrebate(billings, payment.Rebate)
+
+	reopened, rest := reopen(billings, restored.Subtract(payment.Rebate))
+	reopened = append(unrebated, reopened...)
+
+	loanUpdateParams := loan.InstallmentLoan
+	loanUpdateParams.Credit = credit
+	loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Add(restored.Subtract(rest))
+	loanUpdateParams.IsCompleted = loanUpdateParams.OutstandingBalance <= 0
+
+	payment.ReversedAt = when
+	payment.ReversalReason = reason
+
+	err = tx.UpdatePayment(loanID, payment)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateLoan(loanID, loanUpdateParams)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateBillings(loanID, reopened)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	// the reopened billings are charged late as if they were never paid, then they may be missed again
+	_, err = accrueLateCharges(tx, loanID, when, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	_, err = checkDelinquency(tx, loanID, when, ls.clock.Now())
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
+
+// unrebate takes the rebate of a settlement off the billings, they are owed in full again. `billings` is updated in
+// place, the touched billings are returned
+func unrebate(billings []model.Billing, rebate currency.Rupiah) []model.Billing {
+	var touched []model.Billing
+
+	if !(rebate > 0) {
+		return touched
+	}
+
+	for i := range billings {
+		if !(billings[i].Rebate > 0) {
+			continue
+		}
+
+		billings[i].Rebate = currency.NewRupiah(0, 0)
+		touched = append(touched, billings[i])
+	}
+
+	return touched
+}
+
+// reopen takes `amount` back from the paid billings, the latest billing first and the reverse of the `waterfall` within
+// a billing, which exactly undoes the latest payments. `billings` is updated in place, the touched billings and what
+// couldn't be taken back are returned
+func reopen(billings []model.Billing, amount currency.Rupiah) ([]model.Billing, currency.Rupiah) {
+	var touched []model.Billing
+
+	for i := len(billings) - 1; i >= 0; i-- {
+		if !(amount > 0) {
+			break
+		}
+
+		b := &billings[i]
+		if !(b.PaidAmount > 0) {
+			continue
+		}
+
+		for _, paid := range []*currency.Rupiah{&b.PaidPrincipal, &b.PaidInterest, &b.PaidFee, &b.PaidPenalty} {
+			take := min(amount, *paid)
+			if !(take > 0) {
+				continue
+			}
+
+			*paid = paid.Subtract(take)
+			b.PaidAmount = b.PaidAmount.Subtract(take)
+			amount = amount.Subtract(take)
+		}
+
+		touched = append(touched, *b)
+	}
+
+	return touched, amount
+}
@@ -0,0 +1,194 @@
+package loan_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+	"go.jetify.com/typeid"
+)
+
+func TestReversePayment(t *testing.T) {
+	t.Parallel()
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	}
+
+	setup := func(g *WithT, policy model.AllocationPolicy) (*loan.LoanService, *clocktest.Clock, model.InstallmentLoan) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+		param := param
+		param.AllocationPolicy = policy
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		return loanService, clock, createdLoan
+	}
+
+	t.Run("Reopens the Billings", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationApplyToFuture)
+
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 2), currency.NewRupiah(220000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(3)
+		reversed, err := loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(reversed.IsReversed()).To(BeTrue())
+		g.Expect(reversed.ReversedAt).To(Equal(clock.Now()))
+		g.Expect(reversed.ReversalReason).To(Equal("bounced"))
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(1100000, 0)))
+		g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+		g.Expect(updatedLoan.Payments).To(HaveLen(1), "kept for the audit")
+		g.Expect(updatedLoan.Payments[0]).To(Equal(reversed))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		for _, b := range billings {
+			g.Expect(b.PaidAmount).To(BeZero())
+			g.Expect(b.PaidPrincipal).To(BeZero())
+			g.Expect(b.PaidInterest).To(BeZero())
+		}
+
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).To(MatchError(model.ErrPaymentReversed))
+	})
+
+	t.Run("Delinquent Again", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationApplyToFuture)
+
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(330000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// the first 3 installments are overdue once the payment is gone
+		clock.AdvanceDays(22)
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "chargeback")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsDelinquent).To(BeTrue())
+		g.Expect(updatedLoan.DelinquentAt).To(Equal(clock.Now()))
+
+		history, err := loanService.GetDelinquencyHistory(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(1))
+		g.Expect(history[0].Kind).To(Equal(model.DelinquencyEventDelinquent))
+		g.Expect(history[0].MissedBillings).To(Equal(3))
+	})
+
+	t.Run("Reopens a Completed Loan", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationApplyToFuture)
+
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(1100000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(2)
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsCompleted).To(BeFalse())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(1100000, 0)))
+
+		// it takes payments again
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(110000, 0))).To(Succeed())
+	})
+
+	t.Run("Settlement", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationApplyToFuture)
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(110000, 0))).To(Succeed())
+
+		settledAt := clock.Now().AddDate(0, 0, 2)
+		quote, err := loanService.QuotePayoff(createdLoan.ID, settledAt)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(quote.InterestRebate).To(BeNumerically(">", 0))
+		settlement, err := loanService.SettleLoan(createdLoan.ID, settledAt, quote.PayoffAmount)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(settlement.Rebate).To(Equal(quote.InterestRebate))
+
+		// the rebate is given back with the settlement
+		clock.AdvanceDays(3)
+		_, err = loanService.ReversePayment(createdLoan.ID, settlement.ID, clock.Now(), "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsCompleted).To(BeFalse())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(1100000-110000, 0)))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].IsPaid()).To(BeTrue())
+		for _, b := range billings[1:] {
+			g.Expect(b.PaidAmount).To(BeZero())
+			g.Expect(b.Rebate).To(BeZero())
+		}
+	})
+
+	t.Run("Credit Drawn by a Later Payment", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationHoldAsCredit)
+
+		// pays the first installment and holds 40000, which the next payment draws
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(150000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now().AddDate(0, 0, 8), currency.NewRupiah(70000, 0))).To(Succeed())
+
+		clock.AdvanceDays(9)
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		// only the 70000 is left paid
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.Credit).To(BeZero())
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(1100000-70000, 0)))
+
+		billings, err := loanService.GetBillingSchedule(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(billings[0].PaidAmount).To(Equal(currency.NewRupiah(70000, 0)))
+		g.Expect(billings[1].PaidAmount).To(BeZero())
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan := setup(g, model.AllocationApplyToFuture)
+
+		paidAt := clock.Now().AddDate(0, 0, 1)
+		payment, err := loanService.MakePayment(createdLoan.ID, paidAt, currency.NewRupiah(110000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, paidAt, " ")
+		g.Expect(err).To(MatchError(model.ErrNoReversalReason))
+
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, paidAt.Add(-time.Second), "bounced")
+		g.Expect(err).To(MatchError(model.ErrReversalBeforePayment))
+
+		unknownID, err := typeid.New[model.PaymentID]()
+		g.Expect(err).ToNot(HaveOccurred())
+		_, err = loanService.ReversePayment(createdLoan.ID, unknownID, paidAt, "bounced")
+		g.Expect(err).To(MatchError(model.ErrPaymentNotFound))
+	})
+}
@@ -0,0 +1,85 @@
+package loan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+// TestScenarioMissedWeeks walks a weekly loan through the weeks with a fake clock: paid on time, missing two
+// installments, paying the arrears back and the end-of-day batch of the next day
+func TestScenarioMissedWeeks(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+	loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(), loan.WithClock(clock))
+
+	createdLoan, err := loanService.CreateLoan(model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(createdLoan.StartDate).To(Equal(clock.Now()))
+	g.Expect(createdLoan.DueDate(1)).To(Equal(time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)))
+
+	// week 1, paid on its due date
+	clock.AdvanceDays(7)
+	g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(110000, 0))).To(Succeed())
+
+	// weeks 2 and 3 are missed, the second one is only missed after its due date
+	clock.AdvanceDays(14)
+	isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeFalse())
+
+	clock.AdvanceDays(1)
+	isDelinquent, err = loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(isDelinquent).To(BeTrue())
+
+	aging, err := loanService.AgeLoan(createdLoan.ID, clock.Now())
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(aging.DaysPastDue).To(Equal(8))
+	g.Expect(aging.OverdueAmount).To(Equal(currency.NewRupiah(220000, 0)))
+
+	// the arrears are paid back a day later
+	clock.AdvanceDays(1)
+	g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(220000, 0))).To(Succeed())
+
+	history, err := loanService.GetDelinquencyHistory(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(history).To(HaveLen(2))
+	g.Expect(history[0].Kind).To(Equal(model.DelinquencyEventDelinquent))
+	g.Expect(history[0].At).To(Equal(time.Date(2024, 1, 23, 9, 0, 0, 0, time.UTC)))
+	g.Expect(history[1].Kind).To(Equal(model.DelinquencyEventCured))
+	g.Expect(history[1].At).To(Equal(time.Date(2024, 1, 24, 9, 0, 0, 0, time.UTC)))
+
+	// the business date is over on the next day
+	_, err = loanService.RunEndOfDay(context.Background(), clock.Now(), func(model.EndOfDayResult) {})
+	g.Expect(err).To(MatchError(model.ErrBusinessDayNotOver))
+
+	clock.AdvanceDays(1)
+	run, err := loanService.RunEndOfDay(context.Background(), clock.Now().AddDate(0, 0, -1), func(result model.EndOfDayResult) {
+		g.Expect(result.IsDelinquent).To(BeFalse())
+		g.Expect(result.Aging.Bucket).To(Equal(model.BucketCurrent))
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(run.RunKey).To(Equal("2024-01-24"))
+	g.Expect(run.Processed).To(Equal(1))
+	g.Expect(run.StartedAt).To(Equal(clock.Now()))
+
+	updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(updatedLoan.OutstandingBalance).To(Equal(currency.NewRupiah(1100000-330000, 0)))
+	g.Expect(updatedLoan.IsDelinquent).To(BeFalse())
+}
@@ -0,0 +1,195 @@
+package loan
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	"go.jetify.com/typeid"
+)
+
+// WriteOffLoan charges the loan off at `param.When`: the late charges are accrued up to then, the credit held is drawn
+// and what is left to pay of every billing is written off, component by component. From then on nothing is charged
+// late anymore, the delinquency stays as it was and the end-of-day batch skips the loan. The borrower still owes the
+// outstanding balance, what is collected afterwards is recorded as a recovery
+func (ls *LoanService) WriteOffLoan(loanID model.LoanID, param model.WriteOffParam) (model.WriteOff, error) {
+	now := ls.clock.Now().UTC()
+
+	when := param.When.UTC()
+	if param.When.IsZero() {
+		when = now
+	}
+
+	var writeOff model.WriteOff
+	err := ls.storage.WithinTx(func(tx ports.LoanStorage) error {
+		// the late charges up to the write-off are written off as well
+		_, err := accrueLateCharges(tx, loanID, when, ls.clock.Now(), ls.lateFeePolicy, ls.rounding)
+		if err != nil {
+			return err
+		}
+
+		loan, err := tx.GetLoanWithDelinquency(loanID)
+		if err != nil {
+			return err
+		}
+
+		if loan.IsCompleted {
+			return model.ErrRepaymentComplete
+		}
+
+		if loan.IsWrittenOff() {
+			return model.ErrLoanWrittenOff
+		}
+
+		if when.Before(loan.StartDate) || when.After(now) {
+			return model.ErrInvalidWriteOffDate
+		}
+
+		// the delinquency is frozen as it is at the write-off
+		_, err = checkDelinquency(tx, loanID, when, now)
+		if err != nil {
+			return err
+		}
+
+		billings, err := tx.GetBillings(loanID)
+		if err != nil {
+			return err
+		}
+
+		drawn, rest := allocate(billings, loan.Credit, &model.Payment{}, func(model.Billing) bool { return true })
+
+		err = tx.UpdateBillings(loanID, drawn)
+		if err != nil {
+			return err
+		}
+
+		writeOff = model.WriteOff{
+			LoanID:    loanID,
+			At:        when,
+			Principal: currency.NewRupiah(0, 0),
+			Interest:  currency.NewRupiah(0, 0),
+			Fee:       currency.NewRupiah(0, 0),
+			Penalty:   currency.NewRupiah(0, 0),
+			Reason:    param.Reason,
+		}
+
+		for _, b := range billings {
+			if b.IsPaid() {
+				continue
+			}
+
+			writeOff.Principal = writeOff.Principal.Add(b.Principal.Subtract(b.PaidPrincipal))
+			writeOff.Interest = writeOff.Interest.Add(b.Interest.Subtract(b.PaidInterest))
+			writeOff.Fee = writeOff.Fee.Add(b.Fee.Subtract(b.PaidFee))
+			writeOff.Penalty = writeOff.Penalty.Add(b.Penalty.Subtract(b.PaidPenalty))
+		}
+
+		loanUpdateParams := loan.InstallmentLoan
+		loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Subtract(loan.Credit.Subtract(rest))
+		loanUpdateParams.Credit = rest
+		loanUpdateParams.WrittenOffAt = when
+
+		err = tx.UpdateLoan(loanID, loanUpdateParams)
+		if err != nil {
+			return err
+		}
+
+		return tx.CreateWriteOff(loanID, writeOff)
+	})
+	if err != nil {
+		return model.WriteOff{}, err
+	}
+
+	return writeOff, nil
+}
+
+// GetWriteOffReport tells what has been written off of the loan and what has been recovered of it since
+func (ls *LoanService) GetWriteOffReport(loanID model.LoanID) (model.WriteOffReport, error) {
+	loan, err := ls.storage.GetLoanFullInformation(loanID)
+	if err != nil {
+		return model.WriteOffReport{}, err
+	}
+
+	writeOff, err := ls.storage.GetWriteOff(loanID)
+	if err != nil {
+		return model.WriteOffReport{}, err
+	}
+
+	report := model.WriteOffReport{
+		WriteOff:   writeOff,
+		Recovered:  currency.NewRupiah(0, 0),
+		Recoveries: []model.Payment{},
+	}
+
+	for _, p := range loan.Payments {
+		if p.Kind != model.PaymentRecovery {
+			continue
+		}
+
+		if !p.IsReversed() {
+			report.Recovered = report.Recovered.Add(p.Applied())
+		}
+		report.Recoveries = append(report.Recoveries, p)
+	}
+
+	return report, nil
+}
+
+// recordRecovery applies a payment of a written-off loan to what has been written off, oldest billing first. The
+// delinquency is not evaluated anymore and the allocation policy doesn't matter, the payment has been validated by
+// the caller
+func recordRecovery(tx ports.LoanStorage, loan model.LoanWithDelinquency, when time.Time, paymentAmount currency.Rupiah, reference string) (model.Payment, error) {
+	if when.Before(loan.WrittenOffAt) {
+		return model.Payment{}, model.ErrRecoveryBeforeWriteOff
+	}
+
+	billings, err := tx.GetBillings(loan.ID)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	paymentID, err := typeid.New[model.PaymentID]()
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	payment := model.Payment{
+		ID:            paymentID,
+		LoanID:        loan.ID,
+		Kind:          model.PaymentRecovery,
+		Reference:     reference,
+		Date:          when,
+		Amount:        paymentAmount,
+		BalanceBefore: loan.OutstandingBalance,
+	}
+
+	recovered, rest := allocate(billings, paymentAmount.Add(loan.Credit), &payment, func(model.Billing) bool { return true })
+
+	loanUpdateParams := loan.InstallmentLoan
+	loanUpdateParams.Credit = rest
+	loanUpdateParams.OutstandingBalance = loan.OutstandingBalance.Subtract(payment.Applied())
+	if loanUpdateParams.OutstandingBalance <= 0 {
+		loanUpdateParams.OutstandingBalance = currency.NewRupiah(0, 0)
+		loanUpdateParams.IsCompleted = true
+	}
+
+	payment.BalanceAfter = loanUpdateParams.OutstandingBalance
+
+	err = tx.RecordPayment(loan.ID, payment)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateLoan(loan.ID, loanUpdateParams)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	err = tx.UpdateBillings(loan.ID, recovered)
+	if err != nil {
+		return model.Payment{}, err
+	}
+
+	return payment, nil
+}
@@ -0,0 +1,161 @@
+package loan_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock/clocktest"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+	. "github.com/onsi/gomega"
+)
+
+func TestWriteOffLoan(t *testing.T) {
+	t.Parallel()
+
+	// every installment is 100000 principal + 10000 interest, due every 7 days
+	param := model.LoanParam{
+		Principal:          currency.NewRupiah(1000000, 0),
+		AnnualInterestRate: model.BPS(1000),
+		LoanTerm:           10,
+		RateBasis:          model.RatePerTenor,
+	}
+
+	// the first installment is paid, the second and the third are missed and charged 5000 each at day 22
+	setup := func(g *WithT) (*loan.LoanService, *clocktest.Clock, model.InstallmentLoan, model.Payment) {
+		clock := clocktest.NewClock(time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC))
+		loanService := loan.NewLoanService(memorystorage.NewLoanMemoryStorage(),
+			loan.WithClock(clock),
+			loan.WithLateFeePolicy(model.LateFeePolicy{FixedFee: currency.NewRupiah(5000, 0)}),
+		)
+
+		createdLoan, err := loanService.CreateLoan(param)
+		g.Expect(err).ToNot(HaveOccurred())
+
+		payment, err := loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, 1), currency.NewRupiah(110000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		clock.AdvanceDays(22)
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		return loanService, clock, createdLoan, payment
+	}
+
+	t.Run("Charges Off the Loan", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, _ := setup(g)
+
+		writeOff, err := loanService.WriteOffLoan(createdLoan.ID, model.WriteOffParam{Reason: "deceased"})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(writeOff).To(Equal(model.WriteOff{
+			LoanID:    createdLoan.ID,
+			At:        clock.Now(),
+			Principal: currency.NewRupiah(900000, 0),
+			Interest:  currency.NewRupiah(90000, 0),
+			Fee:       currency.NewRupiah(10000, 0),
+			Penalty:   currency.NewRupiah(0, 0),
+			Reason:    "deceased",
+		}))
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.WrittenOffAt).To(Equal(clock.Now()))
+		g.Expect(updatedLoan.OutstandingBalance).To(Equal(writeOff.Amount()), "still owed")
+
+		// nothing is charged and the delinquency stays as it was
+		clock.AdvanceDays(30)
+		charged, err := loanService.AccrueLateCharges(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(charged).To(BeZero())
+
+		isDelinquent, err := loanService.CheckDelinquency(createdLoan.ID, clock.Now())
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(isDelinquent).To(BeTrue())
+
+		history, err := loanService.GetDelinquencyHistory(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(history).To(HaveLen(1))
+
+		run, err := loanService.RunEndOfDay(context.Background(), clock.Now().AddDate(0, 0, -1), func(model.EndOfDayResult) {})
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(run.Processed).To(BeZero())
+	})
+
+	t.Run("Recovers", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, _ := setup(g)
+
+		// written off the day before, the third installment was not late yet
+		_, err := loanService.WriteOffLoan(createdLoan.ID, model.WriteOffParam{When: clock.Now().AddDate(0, 0, -1)})
+		g.Expect(err).ToNot(HaveOccurred())
+
+		_, err = loanService.MakePayment(createdLoan.ID, clock.Now().AddDate(0, 0, -2), currency.NewRupiah(300000, 0), "")
+		g.Expect(err).To(MatchError(model.ErrRecoveryBeforeWriteOff))
+
+		recovery, err := loanService.MakePayment(createdLoan.ID, clock.Now(), currency.NewRupiah(300000, 0), "")
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(recovery.Kind).To(Equal(model.PaymentRecovery))
+		g.Expect(recovery.BalanceAfter).To(Equal(currency.NewRupiah(695000, 0)))
+
+		report, err := loanService.GetWriteOffReport(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(report.Amount()).To(Equal(currency.NewRupiah(995000, 0)))
+		g.Expect(report.Recovered).To(Equal(currency.NewRupiah(300000, 0)))
+		g.Expect(report.Recoveries).To(Equal([]model.Payment{recovery}))
+
+		// a bounced recovery is taken back
+		_, err = loanService.ReversePayment(createdLoan.ID, recovery.ID, clock.Now(), "bounced")
+		g.Expect(err).ToNot(HaveOccurred())
+
+		report, err = loanService.GetWriteOffReport(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(report.Recovered).To(BeZero())
+		g.Expect(report.Recoveries).To(HaveLen(1))
+
+		g.Expect(loanService.RecordPayment(createdLoan.ID, clock.Now(), currency.NewRupiah(995000, 0))).To(Succeed())
+
+		updatedLoan, err := loanService.GetLoan(createdLoan.ID)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(updatedLoan.IsCompleted).To(BeTrue())
+		g.Expect(updatedLoan.Payments[0].Kind).To(Equal(model.PaymentRepayment))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		loanService, clock, createdLoan, payment := setup(g)
+
+		writeOff := func(param model.WriteOffParam) error {
+			_, err := loanService.WriteOffLoan(createdLoan.ID, param)
+			return err
+		}
+
+		_, err := loanService.GetWriteOffReport(createdLoan.ID)
+		g.Expect(err).To(MatchError(model.ErrWriteOffNotFound))
+
+		g.Expect(writeOff(model.WriteOffParam{When: createdLoan.StartDate.Add(-time.Second)})).To(MatchError(model.ErrInvalidWriteOffDate))
+		g.Expect(writeOff(model.WriteOffParam{When: clock.Now().Add(time.Second)})).To(MatchError(model.ErrInvalidWriteOffDate))
+
+		g.Expect(writeOff(model.WriteOffParam{})).To(Succeed())
+		g.Expect(writeOff(model.WriteOffParam{})).To(MatchError(model.ErrLoanWrittenOff))
+
+		_, err = loanService.RestructureLoan(createdLoan.ID, model.RestructureParam{LoanTerm: 5})
+		g.Expect(err).To(MatchError(model.ErrLoanWrittenOff))
+
+		_, err = loanService.DeferInstallments(createdLoan.ID, model.DeferralParam{Periods: 1})
+		g.Expect(err).To(MatchError(model.ErrLoanWrittenOff))
+
+		_, err = loanService.QuotePayoff(createdLoan.ID, clock.Now())
+		g.Expect(err).To(MatchError(model.ErrLoanWrittenOff))
+		_, err = loanService.SettleLoan(createdLoan.ID, clock.Now(), currency.NewRupiah(1000000, 0))
+		g.Expect(err).To(MatchError(model.ErrLoanWrittenOff))
+
+		// what has been written off stays
+		_, err = loanService.ReversePayment(createdLoan.ID, payment.ID, clock.Now(), "bounced")
+		g.Expect(err).To(MatchError(model.ErrLoanWrittenOff))
+	})
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// Bucket groups the loans by their days past due (DPD)
+type Bucket string
+
+const (
+	BucketCurrent Bucket = "current"
+	Bucket1To30   Bucket = "1_30"
+	Bucket31To60  Bucket = "31_60"
+	Bucket61To90  Bucket = "61_90"
+	Bucket90Plus  Bucket = "90_plus"
+)
+
+// BucketOf classifies the days past due
+func BucketOf(daysPastDue int) Bucket {
+	switch {
+	case daysPastDue <= 0:
+		return BucketCurrent
+	case daysPastDue <= 30:
+		return Bucket1To30
+	case daysPastDue <= 60:
+		return Bucket31To60
+	case daysPastDue <= 90:
+		return Bucket61To90
+	default:
+		return Bucket90Plus
+	}
+}
+
+// Kolektibilitas is the OJK loan quality classification (POJK 40/POJK.03/2019), by the days past due
+type Kolektibilitas int
+
+const (
+	KolektibilitasLancar               Kolektibilitas = 1 // current
+	KolektibilitasDalamPerhatianKhusus Kolektibilitas = 2 // 1-90 DPD
+	KolektibilitasKurangLancar         Kolektibilitas = 3 // 91-120 DPD
+	KolektibilitasDiragukan            Kolektibilitas = 4 // 121-180 DPD
+	KolektibilitasMacet                Kolektibilitas = 5 // more than 180 DPD
+)
+
+// KolektibilitasOf classifies the days past due
+func KolektibilitasOf(daysPastDue int) Kolektibilitas {
+	switch {
+	case daysPastDue <= 0:
+		return KolektibilitasLancar
+	case daysPastDue <= 90:
+		return KolektibilitasDalamPerhatianKhusus
+	case daysPastDue <= 120:
+		return KolektibilitasKurangLancar
+	case daysPastDue <= 180:
+		return KolektibilitasDiragukan
+	default:
+		return KolektibilitasMacet
+	}
+}
+
+// Aging is how late a loan is at `AsOf`, the days past due count from the due date of the oldest unpaid billing
+type Aging struct {
+	LoanID         LoanID          `json:"loan_id"`
+	AsOf           time.Time       `json:"as_of"`
+	DaysPastDue    int             `json:"days_past_due"`
+	Bucket         Bucket          `json:"bucket"`
+	Kolektibilitas Kolektibilitas  `json:"kolektibilitas"`
+	OverdueAmount  currency.Rupiah `json:"overdue_amount"` // what is left to pay of the billings past their due date
+}
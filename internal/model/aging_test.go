@@ -0,0 +1,37 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	. "github.com/onsi/gomega"
+)
+
+func TestAgingClassification(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testCases := []struct {
+		daysPastDue    int
+		bucket         model.Bucket
+		kolektibilitas model.Kolektibilitas
+	}{
+		{0, model.BucketCurrent, model.KolektibilitasLancar},
+		{1, model.Bucket1To30, model.KolektibilitasDalamPerhatianKhusus},
+		{30, model.Bucket1To30, model.KolektibilitasDalamPerhatianKhusus},
+		{31, model.Bucket31To60, model.KolektibilitasDalamPerhatianKhusus},
+		{60, model.Bucket31To60, model.KolektibilitasDalamPerhatianKhusus},
+		{61, model.Bucket61To90, model.KolektibilitasDalamPerhatianKhusus},
+		{90, model.Bucket61To90, model.KolektibilitasDalamPerhatianKhusus},
+		{91, model.Bucket90Plus, model.KolektibilitasKurangLancar},
+		{120, model.Bucket90Plus, model.KolektibilitasKurangLancar},
+		{121, model.Bucket90Plus, model.KolektibilitasDiragukan},
+		{180, model.Bucket90Plus, model.KolektibilitasDiragukan},
+		{181, model.Bucket90Plus, model.KolektibilitasMacet},
+	}
+
+	for _, tc := range testCases {
+		g.Expect(model.BucketOf(tc.daysPastDue)).To(Equal(tc.bucket), "%d DPD", tc.daysPastDue)
+		g.Expect(model.KolektibilitasOf(tc.daysPastDue)).To(Equal(tc.kolektibilitas), "%d DPD", tc.daysPastDue)
+	}
+}
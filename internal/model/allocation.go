@@ -0,0 +1,23 @@
+package model
+
+// AllocationPolicy decides what to do with a payment that doesn't match the due billings, partial payments are
+// always applied to the oldest billing first
+type AllocationPolicy string
+
+const (
+	// AllocationApplyToFuture applies the overpayment to the next installments
+	AllocationApplyToFuture AllocationPolicy = "apply_to_future"
+	// AllocationHoldAsCredit holds the overpayment as loan credit that is drawn when the next billing is due
+	AllocationHoldAsCredit AllocationPolicy = "hold_as_credit"
+	// AllocationExactDue only accepts the exact amount of the due billings
+	AllocationExactDue AllocationPolicy = "exact_due"
+)
+
+func (p AllocationPolicy) IsValid() bool {
+	switch p {
+	case AllocationApplyToFuture, AllocationHoldAsCredit, AllocationExactDue:
+		return true
+	default:
+		return false
+	}
+}
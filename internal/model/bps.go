@@ -1,10 +1,18 @@
 package model
 
+import "github.com/shopspring/decimal"
+
 // BPS is a basis point
 type BPS int
 
-func (b BPS) ToPercentage() int { // TODO: use a better way like math/big or Decimal
-	return int(b) / 100
+// ToPercentage is the exact percentage, 1050 bps is 10.5%
+func (b BPS) ToPercentage() decimal.Decimal {
+	return decimal.NewFromInt(int64(b)).Div(decimal.NewFromInt(100))
+}
+
+// Fraction is the rate to multiply an amount with, 1050 bps is 0.105
+func (b BPS) Fraction() decimal.Decimal {
+	return decimal.NewFromInt(int64(b)).Div(decimal.NewFromInt(PERCENT * 100))
 }
 
 func FromPercentage(p int) BPS {
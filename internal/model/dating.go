@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// DatingWindow is how far the start (disbursement) date of a new loan may be from the time it is created, the loans
+// are disbursed elsewhere before they are booked here
+type DatingWindow struct {
+	BackdateDays    int `json:"backdate_days"`     // the start may be up to this many days before the creation
+	ForwardDateDays int `json:"forward_date_days"` // the start may be up to this many days after the creation
+}
+
+// DefaultDatingWindow takes the loans disbursed during the last week, none in the future
+func DefaultDatingWindow() DatingWindow {
+	return DatingWindow{BackdateDays: 7}
+}
+
+// IsValid tells if nothing is negative
+func (w DatingWindow) IsValid() bool {
+	return w.BackdateDays >= 0 && w.ForwardDateDays >= 0
+}
+
+// Contains tells if a loan started at `start` can be created at `now`
+func (w DatingWindow) Contains(start time.Time, now time.Time) bool {
+	return !start.Before(now.AddDate(0, 0, -w.BackdateDays)) && !start.After(now.AddDate(0, 0, w.ForwardDateDays))
+}
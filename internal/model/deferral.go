@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// DeferralMethod is where the deferred installments go
+type DeferralMethod string
+
+const (
+	DeferralExtendTenor DeferralMethod = "extend_tenor" // every installment from the first deferred one is due N periods later, the tenor grows by N
+	DeferralSpread      DeferralMethod = "spread"       // the deferred installments are spread over the remaining ones, the tenor stays
+)
+
+func (m DeferralMethod) IsValid() bool {
+	switch m {
+	case DeferralExtendTenor, DeferralSpread:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeferralParam is the input to give a loan a payment holiday of `Periods` installments from the first installment due
+// after `When`
+type DeferralParam struct {
+	When    time.Time      // optional, default to now
+	Periods int            // number of installments deferred
+	Method  DeferralMethod // optional, default to extend the tenor
+	Reason  string         // optional, e.g. the disaster relief program
+}
+
+// DeferralEvent records a payment holiday given to a loan
+type DeferralEvent struct {
+	LoanID         LoanID          `json:"loan_id"`
+	At             time.Time       `json:"at"`
+	FromTerm       int             `json:"from_term"` // the first deferred installment
+	Periods        int             `json:"periods"`
+	Method         DeferralMethod  `json:"method"`
+	DeferredAmount currency.Rupiah `json:"deferred_amount"` // what was left to pay of the deferred installments
+	Reason         string          `json:"reason"`
+}
@@ -0,0 +1,66 @@
+package model
+
+import "time"
+
+// DelinquencyRule tells when a loan is delinquent, the rule is snapshotted onto the loan at creation so a later
+// change of the policy doesn't flip the existing loans
+type DelinquencyRule struct {
+	MissedPaymentThreshold int `json:"missed_payment_threshold"` // delinquent once more billings than this are missed
+	GraceDays              int `json:"grace_days"`               // days after the due date before a billing is missed
+}
+
+// IsValid tells if nothing is negative
+func (r DelinquencyRule) IsValid() bool {
+	return r.MissedPaymentThreshold >= 0 && r.GraceDays >= 0
+}
+
+// DelinquencyPolicy is the delinquency rule of the new loans, a product can override it
+type DelinquencyPolicy struct {
+	DelinquencyRule
+	Products map[string]DelinquencyRule // by `LoanParam.Product`
+}
+
+// DefaultDelinquencyPolicy flags a loan after missing `MISSED_PAYMENT_THRESHOLD`+1 billings, without grace days
+func DefaultDelinquencyPolicy() DelinquencyPolicy {
+	return DelinquencyPolicy{
+		DelinquencyRule: DelinquencyRule{MissedPaymentThreshold: MISSED_PAYMENT_THRESHOLD},
+	}
+}
+
+// RuleOf is the rule of the product, the policy's own rule if the product is not overridden
+func (p DelinquencyPolicy) RuleOf(product string) DelinquencyRule {
+	if rule, ok := p.Products[product]; ok {
+		return rule
+	}
+
+	return p.DelinquencyRule
+}
+
+// IsValid tells if every rule is valid
+func (p DelinquencyPolicy) IsValid() bool {
+	for _, rule := range p.Products {
+		if !rule.IsValid() {
+			return false
+		}
+	}
+
+	return p.DelinquencyRule.IsValid()
+}
+
+// DelinquencyEventKind is the transition of the delinquency of a loan
+type DelinquencyEventKind string
+
+const (
+	// DelinquencyEventDelinquent is recorded when the loan missed more billings than its threshold
+	DelinquencyEventDelinquent DelinquencyEventKind = "delinquent"
+	// DelinquencyEventCured is recorded when the arrears are paid back under the threshold
+	DelinquencyEventCured DelinquencyEventKind = "cured"
+)
+
+// DelinquencyEvent is recorded every time the delinquency of a loan flips
+type DelinquencyEvent struct {
+	LoanID         LoanID               `json:"loan_id"`
+	Kind           DelinquencyEventKind `json:"kind"`
+	At             time.Time            `json:"at"`
+	MissedBillings int                  `json:"missed_billings"` // after the transition
+}
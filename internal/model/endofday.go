@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// BusinessDate is the UTC day of `t`, the end-of-day batch closes a business date at the next midnight
+func BusinessDate(t time.Time) time.Time {
+	t = t.UTC()
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// EndOfDayRunKey is the idempotency key of the end-of-day batch, there is one run per business date
+func EndOfDayRunKey(businessDate time.Time) string {
+	return BusinessDate(businessDate).Format(time.DateOnly)
+}
+
+// EndOfDayRun is the checkpoint of the end-of-day batch of a business date, the active loans are swept in the order
+// of their id so an interrupted run resumes after `Checkpoint`
+type EndOfDayRun struct {
+	RunKey       string          `json:"run_key"`
+	BusinessDate time.Time       `json:"business_date"`
+	StartedAt    time.Time       `json:"started_at"`
+	FinishedAt   time.Time       `json:"finished_at"` // zero until every loan is swept
+	Checkpoint   LoanID          `json:"checkpoint"`  // the last swept loan
+	Processed    int             `json:"processed"`
+	Delinquent   int             `json:"delinquent"` // swept loans delinquent at the end of the day
+	Charged      currency.Rupiah `json:"charged"`    // late charges accrued by the run
+}
+
+// IsFinished tells if every loan of the business date is swept
+func (r EndOfDayRun) IsFinished() bool {
+	return !r.FinishedAt.IsZero()
+}
+
+// EndOfDayResult is what the end-of-day batch did to a single loan
+type EndOfDayResult struct {
+	LoanID       LoanID          `json:"loan_id"`
+	BusinessDate time.Time       `json:"business_date"`
+	IsDelinquent bool            `json:"is_delinquent"`
+	Charged      currency.Rupiah `json:"charged"`
+	Aging        Aging           `json:"aging"`
+}
@@ -6,6 +6,8 @@ var (
 	ErrLoanNotFound              = errors.New("loan not found")
 	ErrPaymentNotFound           = errors.New("payment not found")
 	ErrDelinquencyStatusNotFound = errors.New("delinquency status not found")
+	ErrEndOfDayRunNotFound       = errors.New("end-of-day run not found")
+	ErrWriteOffNotFound          = errors.New("write-off not found")
 
 	ErrNegativeInterest      = errors.New("expect a positive interest")
 	ErrNoPrincipal           = errors.New("expect some principal")
@@ -14,4 +16,35 @@ var (
 	ErrPayInDelinquent       = errors.New("expect not delinquent")
 	ErrCheckFutureDelinquent = errors.New("expect past and present")
 	ErrRepaymentComplete     = errors.New("expect loan not complete")
+	ErrNonPositivePayment    = errors.New("expect a positive payment")
+	ErrOverpayOutstanding    = errors.New("expect payment not more than outstanding")
+	ErrUnknownPolicy         = errors.New("expect a known allocation policy")
+	ErrMismatchPayoff        = errors.New("expect the exact payoff amount")
+	ErrUnknownFrequency      = errors.New("expect a known installment frequency")
+	ErrUnknownInterestMethod = errors.New("expect a known interest method")
+	ErrUnknownRateBasis      = errors.New("expect a known interest rate basis")
+	ErrUnknownDayCount       = errors.New("expect a known day count convention")
+	ErrStartDateOutOfWindow  = errors.New("expect a start date within the dating window")
+	ErrInvalidFirstDueDate   = errors.New("expect the first due date after the start and before the second term")
+
+	ErrIdempotencyKeyConflict = errors.New("expect the same payment for a reused reference")
+
+	ErrPaymentReversed       = errors.New("expect a payment not reversed yet")
+	ErrNoReversalReason      = errors.New("expect a reversal reason")
+	ErrReversalBeforePayment = errors.New("expect the reversal on or after the payment")
+
+	ErrInvalidRestructureDate    = errors.New("expect the restructure between the start of the current terms and now")
+	ErrReversalAcrossRestructure = errors.New("expect a payment made under the current terms")
+
+	ErrNoDeferralPeriod      = errors.New("expect a period to defer")
+	ErrUnknownDeferralMethod = errors.New("expect a known deferral method")
+	ErrInvalidDeferralDate   = errors.New("expect the deferral between the start of the loan and now")
+	ErrNothingToDefer        = errors.New("expect enough upcoming installments to defer")
+
+	ErrLoanWrittenOff         = errors.New("expect a loan not written off")
+	ErrInvalidWriteOffDate    = errors.New("expect the write-off between the start of the loan and now")
+	ErrRecoveryBeforeWriteOff = errors.New("expect the recovery on or after the write-off")
+
+	ErrBusinessDayNotOver    = errors.New("expect the business date over")
+	ErrEndOfDayRunInProgress = errors.New("expect no other end-of-day run of the business date")
 )
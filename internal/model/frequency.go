@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// Frequency is how often an installment is due
+type Frequency string
+
+const (
+	FrequencyWeekly   Frequency = "weekly"
+	FrequencyBiweekly Frequency = "biweekly"
+	FrequencyMonthly  Frequency = "monthly"
+)
+
+func (f Frequency) IsValid() bool {
+	switch f {
+	case FrequencyWeekly, FrequencyBiweekly, FrequencyMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// DueDate is the due date of the `term`-th installment of a loan started at `start`, the 0th is `start` itself.
+// Monthly installments keep the day of month of `start`, clamped to the end of the shorter months
+// (Jan 31 -> Feb 28 -> Mar 31)
+func (f Frequency) DueDate(start time.Time, term int) time.Time {
+	switch f {
+	case FrequencyBiweekly:
+		return start.AddDate(0, 0, 14*term)
+	case FrequencyMonthly:
+		return addMonthsClamped(start, term)
+	default:
+		return start.AddDate(0, 0, 7*term)
+	}
+}
+
+// IsValidFirstDueDate tells if the first installment of a loan started at `start` can be due at `firstDue`: after the
+// start, and not later than the second installment would have been
+func (f Frequency) IsValidFirstDueDate(start time.Time, firstDue time.Time) bool {
+	return firstDue.After(start) && !firstDue.After(f.DueDate(start, 2))
+}
+
+// addMonthsClamped differs from `time.AddDate` which normalizes Jan 31 + 1 month into Mar 3
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	return firstOfMonth.AddDate(0, 0, min(day, lastDay)-1)
+}
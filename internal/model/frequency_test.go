@@ -0,0 +1,142 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	. "github.com/onsi/gomega"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestDueDate(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testCases := []struct {
+		name      string
+		frequency model.Frequency
+		start     time.Time
+		term      int
+		expected  time.Time
+	}{
+		{
+			name:      "Weekly",
+			frequency: model.FrequencyWeekly,
+			start:     date(2024, time.December, 30),
+			term:      2,
+			expected:  date(2025, time.January, 13),
+		},
+		{
+			name:      "Bi-weekly",
+			frequency: model.FrequencyBiweekly,
+			start:     date(2024, time.December, 30),
+			term:      2,
+			expected:  date(2025, time.January, 27),
+		},
+		{
+			name:      "Monthly - Same Day of Month",
+			frequency: model.FrequencyMonthly,
+			start:     date(2024, time.November, 15),
+			term:      3,
+			expected:  date(2025, time.February, 15),
+		},
+		{
+			name:      "Monthly - Clamped to End of February",
+			frequency: model.FrequencyMonthly,
+			start:     date(2025, time.January, 31),
+			term:      1,
+			expected:  date(2025, time.February, 28),
+		},
+		{
+			name:      "Monthly - Clamped to Leap Day",
+			frequency: model.FrequencyMonthly,
+			start:     date(2024, time.January, 30),
+			term:      1,
+			expected:  date(2024, time.February, 29),
+		},
+		{
+			name:      "Monthly - Back to the 31st After a Short Month",
+			frequency: model.FrequencyMonthly,
+			start:     date(2025, time.January, 31),
+			term:      2,
+			expected:  date(2025, time.March, 31),
+		},
+		{
+			name:      "Monthly - Clamped to 30 Days",
+			frequency: model.FrequencyMonthly,
+			start:     date(2025, time.March, 31),
+			term:      1,
+			expected:  date(2025, time.April, 30),
+		},
+		{
+			name:      "Monthly - Across the Year",
+			frequency: model.FrequencyMonthly,
+			start:     date(2024, time.October, 31),
+			term:      4,
+			expected:  date(2025, time.February, 28),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g.Expect(tc.frequency.DueDate(tc.start, tc.term)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestCurrentTerm(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	loan := model.InstallmentLoan{
+		Loan:      model.Loan{StartDate: date(2025, time.January, 31)},
+		Frequency: model.FrequencyMonthly,
+		LoanTerm:  3,
+	}
+
+	g.Expect(loan.CurrentTerm(date(2025, time.January, 1))).To(Equal(0))
+	g.Expect(loan.CurrentTerm(date(2025, time.February, 1))).To(Equal(1))
+	g.Expect(loan.CurrentTerm(date(2025, time.February, 28))).To(Equal(1)) // exactly at the due date
+	g.Expect(loan.CurrentTerm(date(2025, time.March, 1))).To(Equal(2))
+	g.Expect(loan.CurrentTerm(date(2025, time.December, 1))).To(Equal(3))
+}
+
+func TestFirstDueDate(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// disbursed on the 20th, paid on the 1st of every month
+	loan := model.InstallmentLoan{
+		Loan:         model.Loan{StartDate: date(2025, time.January, 20)},
+		Frequency:    model.FrequencyMonthly,
+		FirstDueDate: date(2025, time.February, 1),
+		LoanTerm:     3,
+	}
+
+	g.Expect(loan.DueDate(0)).To(Equal(date(2025, time.January, 20)))
+	g.Expect(loan.DueDate(1)).To(Equal(date(2025, time.February, 1)))
+	g.Expect(loan.DueDate(3)).To(Equal(date(2025, time.April, 1)))
+	g.Expect(loan.CurrentTerm(date(2025, time.February, 2))).To(Equal(2))
+
+	testCases := []struct {
+		name     string
+		firstDue time.Time
+		expected bool
+	}{
+		{name: "Shorter First Term", firstDue: date(2025, time.February, 1), expected: true},
+		{name: "Longer First Term", firstDue: date(2025, time.March, 20), expected: true},
+		{name: "Longer than 2 Terms", firstDue: date(2025, time.March, 21), expected: false},
+		{name: "At the Start", firstDue: date(2025, time.January, 20), expected: false},
+		{name: "Before the Start", firstDue: date(2025, time.January, 1), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g.Expect(model.FrequencyMonthly.IsValidFirstDueDate(loan.StartDate, tc.firstDue)).To(Equal(tc.expected))
+		})
+	}
+}
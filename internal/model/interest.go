@@ -25,3 +25,16 @@ func (pir *PreciseInterestRate) CalculateInterest(principal string) (decimal.Dec
 	}
 	return precisionPrincipal.Mul(pir.rate.Div(decimal.NewFromInt(100))), nil
 }
+
+// InterestMethod is how the interest of every term is computed
+type InterestMethod string
+
+const (
+	// InterestFlat charges the same interest every term, computed from the principal at start
+	InterestFlat InterestMethod = "flat"
+	// InterestDecliningBalance (effective) repays the same principal every term and charges the interest on the
+	// remaining principal
+	InterestDecliningBalance InterestMethod = "declining_balance"
+	// InterestAnnuity repays the same installment every term, the interest part shrinks with the remaining principal
+	InterestAnnuity InterestMethod = "annuity"
+)
@@ -0,0 +1,22 @@
+package model
+
+import "github.com/bahrunnur/loan-billing-service/pkg/currency"
+
+// LateFeePolicy is what an overdue billing is charged, the zero value charges nothing
+type LateFeePolicy struct {
+	GraceDays        int             // days after the due date before the billing is overdue
+	FixedFee         currency.Rupiah // charged once per missed billing, as `Fee`
+	OverdueRate      BPS             // of the overdue principal + interest, charged once per missed billing as `Fee`
+	DailyPenaltyRate BPS             // of the overdue principal + interest, accrued every day since the due date as `Penalty`
+	Cap              currency.Rupiah // max late fee + penalty of a single billing, 0 is no cap
+}
+
+// IsZero tells if the policy never charges anything
+func (p LateFeePolicy) IsZero() bool {
+	return p.FixedFee == 0 && p.OverdueRate == 0 && p.DailyPenaltyRate == 0
+}
+
+// IsValid tells if nothing is negative
+func (p LateFeePolicy) IsValid() bool {
+	return p.GraceDays >= 0 && p.FixedFee >= 0 && p.OverdueRate >= 0 && p.DailyPenaltyRate >= 0 && p.Cap >= 0
+}
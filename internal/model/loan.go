@@ -10,65 +10,174 @@ import (
 type Loan struct {
 	ID                 LoanID          `json:"id"`
 	Principal          currency.Rupiah `json:"principal"`
-	AnnualInterestRate BPS             `json:"annual_interest_rate"` // basis point (1 basis point = 0.01%)
+	AnnualInterestRate BPS             `json:"annual_interest_rate"` // basis point (1 basis point = 0.01%), over `RateBasis`
 	StartDate          time.Time       `json:"start_date"`
 	TotalInterest      currency.Rupiah `json:"total_interest"`
 	OutstandingBalance currency.Rupiah `json:"outstanding_balance"`
 	IsCompleted        bool            `json:"is_completed"`
+	WrittenOffAt       time.Time       `json:"written_off_at"` // zero unless written off, see `WriteOff`
+
+	AllocationPolicy AllocationPolicy `json:"allocation_policy"`
+	Credit           currency.Rupiah  `json:"credit"` // overpayment held by `AllocationHoldAsCredit`
+	InterestMethod   InterestMethod   `json:"interest_method"`
+	RateBasis        RateBasis        `json:"rate_basis"`
+	DayCount         DayCount         `json:"day_count"`
+	Product          string           `json:"product"`
+	DelinquencyRule  DelinquencyRule  `json:"delinquency_rule"` // snapshot of the policy at creation
 }
 
-// WeeklyLoan is Loan for weekly term
-type WeeklyLoan struct {
-	Loan
-	LoanTermWeeks  int             `json:"loan_term_weeks"`
-	WeeklyPayment  currency.Rupiah `json:"weekly_payment"`
-	WeeklyInterest currency.Rupiah `json:"weekly_interest"`
+// IsWrittenOff tells if the loan has been charged off, it only takes recoveries since
+func (l Loan) IsWrittenOff() bool {
+	return !l.WrittenOffAt.IsZero()
+}
+
+// LoanParam is the input to create a loan
+type LoanParam struct {
+	Principal          currency.Rupiah
+	AnnualInterestRate BPS
+	Frequency          Frequency        // optional, default to `FrequencyWeekly`
+	LoanTerm           int              // number of installments
+	AllocationPolicy   AllocationPolicy // optional, default to `AllocationApplyToFuture`
+	InterestMethod     InterestMethod   // optional, default to `InterestFlat`
+	RateBasis          RateBasis        // optional, default to `RateAnnual`
+	DayCount           DayCount         // optional, default to `DayCountAct365`
+	Product            string           // optional, picks the delinquency rule overridden for the product
+	StartDate          time.Time        // optional, default to now, has to be within the `DatingWindow`
+	FirstDueDate       time.Time        // optional, default to one installment after the start
 }
 
-// MonthlyLoan is Loan for monthly term, not used at the moment
-type MonthlyLoan struct {
+// InstallmentLoan is Loan repaid with `LoanTerm` equal installments, one every `Frequency`
+type InstallmentLoan struct {
 	Loan
-	LoanTermMonths int             `json:"loan_term_months"`
-	MonthlyPayment currency.Rupiah `json:"monthly_payment"`
+	Frequency           Frequency       `json:"frequency"`
+	FirstDueDate        time.Time       `json:"first_due_date"`       // zero when one installment after the start
+	LoanTerm            int             `json:"loan_term"`            // number of installments
+	InstallmentAmount   currency.Rupiah `json:"installment_amount"`   // of the first term, see the billings for the rest
+	InstallmentInterest currency.Rupiah `json:"installment_interest"` // of the first term, see the billings for the rest
+	TermsVersion        int             `json:"terms_version"`        // bumped by every restructure, see `LoanTerms`
+}
+
+// DueDate is the due date of the `term`-th installment, the 0th is the start date. With a `FirstDueDate` the first term
+// is shorter or longer, the next ones follow the frequency from it
+func (l InstallmentLoan) DueDate(term int) time.Time {
+	if l.FirstDueDate.IsZero() || term == 0 {
+		return l.Frequency.DueDate(l.StartDate, term)
+	}
+
+	return l.Frequency.DueDate(l.FirstDueDate, term-1)
+}
+
+// CurrentTerm is the installment running at `at`, a term starts right after the previous due date. It is 0 before
+// the loan starts and stays at the last term after the loan matures
+func (l InstallmentLoan) CurrentTerm(at time.Time) int {
+	term := 0
+	for term < l.LoanTerm && l.DueDate(term).Before(at) {
+		term++
+	}
+
+	return term
 }
 
+// PaymentKind tells a repayment of the loan from a recovery of a written-off loan
+type PaymentKind string
+
+const (
+	PaymentRepayment PaymentKind = "repayment"
+	PaymentRecovery  PaymentKind = "recovery" // collected after the write-off, see `WriteOff`
+)
+
 // Payment represents a single loan payment
 type Payment struct {
+	ID            PaymentID       `json:"id"`
 	LoanID        LoanID          `json:"loan_id"`
+	Kind          PaymentKind     `json:"kind"`
+	Reference     string          `json:"reference"` // the idempotency key of the client, unique per loan when given
 	Date          time.Time       `json:"date"`
 	Amount        currency.Rupiah `json:"amount"`
 	BalanceBefore currency.Rupiah `json:"balance_before"`
 	BalanceAfter  currency.Rupiah `json:"balance_after"`
+
+	// what the payment (and the credit drawn with it) has been applied to, anything held as credit is not in here
+	Principal currency.Rupiah `json:"principal"`
+	Interest  currency.Rupiah `json:"interest"`
+	Fee       currency.Rupiah `json:"fee"`
+	Penalty   currency.Rupiah `json:"penalty"`
+	Rebate    currency.Rupiah `json:"rebate"` // the unearned interest given back by a settlement, not paid by the payment
+
+	// a reversed payment (a bounced transfer, a chargeback) is kept for the audit, it is no longer applied to the loan
+	ReversedAt     time.Time `json:"reversed_at"`
+	ReversalReason string    `json:"reversal_reason"`
+}
+
+// IsReversed tells if the payment has been taken back
+func (p Payment) IsReversed() bool {
+	return !p.ReversedAt.IsZero()
+}
+
+// Applied is how much of the loan the payment (and the credit drawn with it) has paid
+func (p Payment) Applied() currency.Rupiah {
+	return p.Principal.Add(p.Interest).Add(p.Fee).Add(p.Penalty)
 }
 
 type Billing struct {
 	LoanID         LoanID          `json:"loan_id"`
+	TermsVersion   int             `json:"terms_version"` // the version of the loan terms that scheduled it
 	TermNumber     int             `json:"term_number"`
 	PaymentDueDate time.Time       `json:"payment_due_date"`
-	Repayment      currency.Rupiah `json:"repayment"`
-	IsPaid         bool            `json:"is_paid"`
+	Repayment      currency.Rupiah `json:"repayment"` // principal + interest + fee + penalty
+	Principal      currency.Rupiah `json:"principal"`
+	Interest       currency.Rupiah `json:"interest"`
+	Fee            currency.Rupiah `json:"fee"`
+	Penalty        currency.Rupiah `json:"penalty"`
+	PaidAmount     currency.Rupiah `json:"paid_amount"` // paid principal + interest + fee + penalty
+	PaidPrincipal  currency.Rupiah `json:"paid_principal"`
+	PaidInterest   currency.Rupiah `json:"paid_interest"`
+	PaidFee        currency.Rupiah `json:"paid_fee"`
+	PaidPenalty    currency.Rupiah `json:"paid_penalty"`
+	Rebate         currency.Rupiah `json:"rebate"`        // the unearned interest given back by a settlement, not paid
+	AccruedUntil   time.Time       `json:"accrued_until"` // late charges have been accrued up to, zero while not overdue
+	SupersededAt   time.Time       `json:"superseded_at"` // closed unpaid by a restructure, see `LoanTerms`
+	DeferredAt     time.Time       `json:"deferred_at"`   // a payment holiday, never missed, see `DeferralEvent`
+}
+
+// IsPaid tells if the billing has been fully paid, the rebate of a settlement counts as paid
+func (b Billing) IsPaid() bool {
+	return b.PaidAmount.Add(b.Rebate) >= b.Repayment
 }
 
-type BillingParam struct {
-	LoanCreationDate time.Time
-	NumberOfTerm     int
-	RepaymentAmount  currency.Rupiah
+// Remaining is the amount left to fully pay the billing
+func (b Billing) Remaining() currency.Rupiah {
+	if b.IsPaid() {
+		return currency.NewRupiah(0, 0)
+	}
+
+	return b.Repayment.Subtract(b.PaidAmount).Subtract(b.Rebate)
 }
 
 // DelinquencyStatus represents the loan's delinquency details
 type DelinquencyStatus struct {
 	LoanID       LoanID          `json:"loan_id"`
 	IsDelinquent bool            `json:"is_delinquent"`
-	LateFee      currency.Rupiah `json:"late_fee"`
+	LateFee      currency.Rupiah `json:"late_fee"` // every late fee and penalty charged so far
+
+	// the aging at `AgedAt`, see `Aging`
+	DaysPastDue    int            `json:"days_past_due"`
+	Bucket         Bucket         `json:"bucket"`
+	Kolektibilitas Kolektibilitas `json:"kolektibilitas"`
+	AgedAt         time.Time      `json:"aged_at"`
+
+	// the latest transitions, see `DelinquencyEvent`
+	DelinquentAt time.Time `json:"delinquent_at"`
+	CuredAt      time.Time `json:"cured_at"`
 }
 
-type WeeklyLoanWithDelinquency struct {
-	WeeklyLoan
+type LoanWithDelinquency struct {
+	InstallmentLoan
 	DelinquencyStatus
 }
 
-type WeeklyLoanFullInformation struct {
-	WeeklyLoan
+type LoanFullInformation struct {
+	InstallmentLoan
 	DelinquencyStatus
 	Payments []Payment `json:"payments"`
 }
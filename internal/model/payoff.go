@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// RebateRule decides how much of the unearned flat interest is given back when a loan is settled early
+type RebateRule string
+
+const (
+	// RebateNone charges the full interest
+	RebateNone RebateRule = "none"
+	// RebateProRata gives back the interest of every term that hasn't started, evenly
+	RebateProRata RebateRule = "pro_rata"
+	// RebateRuleOf78 gives back the unearned interest weighted by the sum of the remaining term digits
+	RebateRuleOf78 RebateRule = "rule_of_78"
+)
+
+func (r RebateRule) IsValid() bool {
+	switch r {
+	case RebateNone, RebateProRata, RebateRuleOf78:
+		return true
+	default:
+		return false
+	}
+}
+
+// PayoffQuote is the amount to close a loan at `QuoteDate`
+type PayoffQuote struct {
+	LoanID             LoanID          `json:"loan_id"`
+	QuoteDate          time.Time       `json:"quote_date"`
+	OutstandingBalance currency.Rupiah `json:"outstanding_balance"`
+	Credit             currency.Rupiah `json:"credit"`
+	RemainingTerms     int             `json:"remaining_terms"` // terms that haven't started at `QuoteDate`
+	RebateRule         RebateRule      `json:"rebate_rule"`
+	InterestRebate     currency.Rupiah `json:"interest_rebate"`
+	PayoffAmount       currency.Rupiah `json:"payoff_amount"` // outstanding - credit - rebate
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// RateBasis is what the interest rate of a loan is quoted over
+type RateBasis string
+
+const (
+	// RateAnnual is a nominal annual rate, every term is charged its share of the year by the day count
+	RateAnnual RateBasis = "annual"
+	// RatePerTenor is a flat rate over the whole loan whatever its length, spread evenly over the terms
+	RatePerTenor RateBasis = "per_tenor"
+	// RateMonthly is a flat rate per month, 12 of them make the annual rate
+	RateMonthly RateBasis = "monthly"
+)
+
+func (r RateBasis) IsValid() bool {
+	switch r {
+	case RateAnnual, RatePerTenor, RateMonthly:
+		return true
+	default:
+		return false
+	}
+}
+
+// DayCount is the convention to tell which fraction of a year a term is
+type DayCount string
+
+const (
+	// DayCountAct365 counts the actual days over a 365 days year
+	DayCountAct365 DayCount = "act_365"
+	// DayCount30E360 counts every month as 30 days over a 360 days year (European), a month is exactly 1/12 year
+	DayCount30E360 DayCount = "30_360"
+)
+
+func (d DayCount) IsValid() bool {
+	switch d {
+	case DayCountAct365, DayCount30E360:
+		return true
+	default:
+		return false
+	}
+}
+
+// YearFraction is the fraction of a year between `from` and `to`
+func (d DayCount) YearFraction(from, to time.Time) decimal.Decimal {
+	switch d {
+	case DayCount30E360:
+		y1, m1, d1 := from.Date()
+		y2, m2, d2 := to.Date()
+		days := 360*(y2-y1) + 30*int(m2-m1) + min(d2, 30) - min(d1, 30)
+
+		return decimal.NewFromInt(int64(days)).Div(decimal.NewFromInt(360))
+	default:
+		days := DaysBetween(from, to)
+
+		return decimal.NewFromInt(int64(days)).Div(decimal.NewFromInt(365))
+	}
+}
+
+// DaysBetween counts the calendar days, the time of day is ignored
+func DaysBetween(from, to time.Time) int {
+	y1, m1, d1 := from.Date()
+	y2, m2, d2 := to.Date()
+
+	start := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+
+	return int(end.Sub(start).Hours() / 24)
+}
@@ -0,0 +1,57 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	. "github.com/onsi/gomega"
+)
+
+func TestYearFraction(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testCases := []struct {
+		name     string
+		dayCount model.DayCount
+		from     time.Time
+		to       time.Time
+		expected string
+	}{
+		{
+			name:     "ACT/365 Week",
+			dayCount: model.DayCountAct365,
+			from:     date(2024, time.December, 30),
+			to:       date(2025, time.January, 6),
+			expected: "0.0191780821917808",
+		},
+		{
+			name:     "ACT/365 February",
+			dayCount: model.DayCountAct365,
+			from:     date(2024, time.February, 15),
+			to:       date(2024, time.March, 15),
+			expected: "0.0794520547945205", // 29 days, leap year
+		},
+		{
+			name:     "30E/360 Month",
+			dayCount: model.DayCount30E360,
+			from:     date(2024, time.February, 15),
+			to:       date(2024, time.March, 15),
+			expected: "0.0833333333333333",
+		},
+		{
+			name:     "30E/360 End of Month",
+			dayCount: model.DayCount30E360,
+			from:     date(2025, time.January, 31),
+			to:       date(2025, time.March, 31),
+			expected: "0.1666666666666667",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g.Expect(tc.dayCount.YearFraction(tc.from, tc.to).String()).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// RestructureParam is the input to restructure a loan, the remaining outstanding is rescheduled from `When` over
+// `LoanTerm` installments at `AnnualInterestRate` (over the rate basis of the loan)
+type RestructureParam struct {
+	When               time.Time // optional, default to now, the new schedule starts at it
+	LoanTerm           int       // number of installments of the new schedule
+	AnnualInterestRate BPS
+	Frequency          Frequency // optional, default to the frequency of the loan
+	FirstDueDate       time.Time // optional, default to one installment after `When`
+
+	// the earned interest, the fees and the penalties left unpaid are added to the principal of the new schedule,
+	// otherwise they are due with its first installment
+	CapitalizeArrears bool
+}
+
+// LoanTerms is a version of the repayment terms of a loan, every restructure supersedes the current version with a
+// new one. The loan carries its current version
+type LoanTerms struct {
+	LoanID             LoanID          `json:"loan_id"`
+	Version            int             `json:"version"`       // 1 for the terms the loan was created with
+	StartDate          time.Time       `json:"start_date"`    // the schedule of the version starts at it
+	SupersededAt       time.Time       `json:"superseded_at"` // zero for the current version
+	Principal          currency.Rupiah `json:"principal"`
+	AnnualInterestRate BPS             `json:"annual_interest_rate"`
+	Frequency          Frequency       `json:"frequency"`
+	FirstDueDate       time.Time       `json:"first_due_date"`
+	LoanTerm           int             `json:"loan_term"`
+	InterestMethod     InterestMethod  `json:"interest_method"`
+	RateBasis          RateBasis       `json:"rate_basis"`
+	DayCount           DayCount        `json:"day_count"`
+	TotalInterest      currency.Rupiah `json:"total_interest"`
+	InstallmentAmount  currency.Rupiah `json:"installment_amount"`
+}
+
+// Terms is the current version of the terms of the loan
+func (l InstallmentLoan) Terms() LoanTerms {
+	return LoanTerms{
+		LoanID:             l.ID,
+		Version:            l.TermsVersion,
+		StartDate:          l.StartDate,
+		Principal:          l.Principal,
+		AnnualInterestRate: l.AnnualInterestRate,
+		Frequency:          l.Frequency,
+		FirstDueDate:       l.FirstDueDate,
+		LoanTerm:           l.LoanTerm,
+		InterestMethod:     l.InterestMethod,
+		RateBasis:          l.RateBasis,
+		DayCount:           l.DayCount,
+		TotalInterest:      l.TotalInterest,
+		InstallmentAmount:  l.InstallmentAmount,
+	}
+}
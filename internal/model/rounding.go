@@ -0,0 +1,36 @@
+package model
+
+import "github.com/shopspring/decimal"
+
+// RoundingMode is how a computed amount is rounded to the sen
+type RoundingMode string
+
+const (
+	// RoundHalfEven rounds the halves to the even sen (banker's rounding), it doesn't drift over many terms
+	RoundHalfEven RoundingMode = "half_even"
+	// RoundHalfUp rounds the halves away from zero
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundFloor always rounds down, never charges the borrower the fraction of a sen
+	RoundFloor RoundingMode = "floor"
+)
+
+func (m RoundingMode) IsValid() bool {
+	switch m {
+	case RoundHalfEven, RoundHalfUp, RoundFloor:
+		return true
+	default:
+		return false
+	}
+}
+
+// Round rounds `d` to `places` decimal places
+func (m RoundingMode) Round(d decimal.Decimal, places int32) decimal.Decimal {
+	switch m {
+	case RoundHalfUp:
+		return d.Round(places)
+	case RoundFloor:
+		return d.RoundFloor(places)
+	default:
+		return d.RoundBank(places)
+	}
+}
@@ -0,0 +1,48 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	. "github.com/onsi/gomega"
+	"github.com/shopspring/decimal"
+)
+
+func TestRound(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	testCases := []struct {
+		value    string
+		halfEven string
+		halfUp   string
+		floor    string
+	}{
+		{value: "2.5", halfEven: "2", halfUp: "3", floor: "2"},
+		{value: "3.5", halfEven: "4", halfUp: "4", floor: "3"},
+		{value: "2.7", halfEven: "3", halfUp: "3", floor: "2"},
+		{value: "2.2", halfEven: "2", halfUp: "2", floor: "2"},
+		{value: "-2.5", halfEven: "-2", halfUp: "-3", floor: "-3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			d := decimal.RequireFromString(tc.value)
+
+			g.Expect(model.RoundHalfEven.Round(d, 0).String()).To(Equal(tc.halfEven))
+			g.Expect(model.RoundHalfUp.Round(d, 0).String()).To(Equal(tc.halfUp))
+			g.Expect(model.RoundFloor.Round(d, 0).String()).To(Equal(tc.floor))
+		})
+	}
+
+	g.Expect(model.RoundingMode("ceil").IsValid()).To(BeFalse())
+}
+
+func TestBPS(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(model.BPS(1050).ToPercentage().String()).To(Equal("10.5"))
+	g.Expect(model.BPS(1050).Fraction().String()).To(Equal("0.105"))
+	g.Expect(model.BPS(1).Fraction().String()).To(Equal("0.0001"))
+}
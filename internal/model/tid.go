@@ -9,3 +9,11 @@ func (LoanPrefix) Prefix() string { return "loan" }
 type LoanID struct {
 	typeid.TypeID[LoanPrefix]
 }
+
+type PaymentPrefix struct{}
+
+func (PaymentPrefix) Prefix() string { return "payment" }
+
+type PaymentID struct {
+	typeid.TypeID[PaymentPrefix]
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
+)
+
+// WriteOffParam is the input to write off a loan at `When`
+type WriteOffParam struct {
+	When   time.Time // optional, default to now
+	Reason string    // optional, e.g. the borrower passed away
+}
+
+// WriteOff records the charge-off of a loan nobody expects to be repaid anymore: what was left to pay of it is taken
+// off the books. The borrower still owes it, what is collected afterwards is a recovery (see `PaymentRecovery`)
+type WriteOff struct {
+	LoanID    LoanID          `json:"loan_id"`
+	At        time.Time       `json:"at"`
+	Principal currency.Rupiah `json:"principal"`
+	Interest  currency.Rupiah `json:"interest"`
+	Fee       currency.Rupiah `json:"fee"`
+	Penalty   currency.Rupiah `json:"penalty"`
+	Reason    string          `json:"reason"`
+}
+
+// Amount is everything written off
+func (w WriteOff) Amount() currency.Rupiah {
+	return w.Principal.Add(w.Interest).Add(w.Fee).Add(w.Penalty)
+}
+
+// WriteOffReport is the write-off of a loan with what has been recovered of it since
+type WriteOffReport struct {
+	WriteOff
+	Recovered  currency.Rupiah `json:"recovered"`  // by the recovery payments not reversed
+	Recoveries []Payment       `json:"recoveries"` // every recovery payment, oldest first
+}
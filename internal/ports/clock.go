@@ -0,0 +1,9 @@
+package ports
+
+import "time"
+
+// Clock tells the current time, the use cases and the handlers never read the wall time directly so the tests can
+// control it
+type Clock interface {
+	Now() time.Time
+}
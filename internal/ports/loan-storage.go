@@ -7,18 +7,33 @@ import (
 )
 
 type LoanCreator interface {
-	CreateLoan(loan model.WeeklyLoan) error
+	CreateLoan(loan model.InstallmentLoan) error
 }
 
 type LoanGetter interface {
-	GetLoan(loanID model.LoanID) (model.WeeklyLoan, error)
-	GetLoanWithDelinquency(loanID model.LoanID) (model.WeeklyLoanWithDelinquency, error)
-	GetLoanFullInformation(loanID model.LoanID) (model.WeeklyLoanFullInformation, error)
+	GetLoan(loanID model.LoanID) (model.InstallmentLoan, error)
+	GetLoanWithDelinquency(loanID model.LoanID) (model.LoanWithDelinquency, error)
+	GetLoanFullInformation(loanID model.LoanID) (model.LoanFullInformation, error)
+}
+
+type LoanLister interface {
+	// ListActiveLoans returns up to `limit` ids of the loans neither completed nor written off, ordered by id and after
+	// `after` (the zero id starts from the first loan)
+	ListActiveLoans(after model.LoanID, limit int) ([]model.LoanID, error)
 }
 
 type LoanUpdater interface {
-	UpdateLoan(loanID model.LoanID, updateParams model.WeeklyLoan) error
-	UpdateLoanDelinquency(loanID model.LoanID, delinquency bool) error
+	UpdateLoan(loanID model.LoanID, updateParams model.InstallmentLoan) error
+}
+
+type LoanTermsInserter interface {
+	// RecordLoanTerms keeps a superseded version of the loan terms
+	RecordLoanTerms(loanID model.LoanID, terms model.LoanTerms) error
+}
+
+type LoanTermsGetter interface {
+	// GetLoanTerms returns the superseded versions of the loan terms, oldest first. The current one is on the loan
+	GetLoanTerms(loanID model.LoanID) ([]model.LoanTerms, error)
 }
 
 type DelinquencyStatusCreator interface {
@@ -33,18 +48,113 @@ type DelinquencyStatusUpdater interface {
 	UpdateDelinquencyStatus(loanID model.LoanID, updateParams model.DelinquencyStatus) error
 }
 
+type DelinquencyEventInserter interface {
+	RecordDelinquencyEvent(loanID model.LoanID, event model.DelinquencyEvent) error
+}
+
+type DelinquencyEventGetter interface {
+	// GetDelinquencyEvents returns the delinquency transitions of the loan, oldest first
+	GetDelinquencyEvents(loanID model.LoanID) ([]model.DelinquencyEvent, error)
+}
+
+type DeferralEventInserter interface {
+	RecordDeferralEvent(loanID model.LoanID, event model.DeferralEvent) error
+}
+
+type DeferralEventGetter interface {
+	// GetDeferralEvents returns the payment holidays given to the loan, oldest first
+	GetDeferralEvents(loanID model.LoanID) ([]model.DeferralEvent, error)
+}
+
+type WriteOffCreator interface {
+	CreateWriteOff(loanID model.LoanID, writeOff model.WriteOff) error
+}
+
+type WriteOffGetter interface {
+	// GetWriteOff fails with `model.ErrWriteOffNotFound` when the loan has not been written off
+	GetWriteOff(loanID model.LoanID) (model.WriteOff, error)
+}
+
 type PaymentInserter interface {
+	// RecordPayment fails with `model.ErrIdempotencyKeyConflict` when the reference of the payment is already taken
 	RecordPayment(loanID model.LoanID, payment model.Payment) error
 }
 
+type PaymentGetter interface {
+	GetPayment(loanID model.LoanID, paymentID model.PaymentID) (model.Payment, error)
+	GetPaymentByReference(loanID model.LoanID, reference string) (model.Payment, error)
+}
+
+type PaymentUpdater interface {
+	// UpdatePayment stores the reversal of the payment, what it has been applied to is never changed
+	UpdatePayment(loanID model.LoanID, payment model.Payment) error
+}
+
 type BillingInserter interface {
-	CreateBilling(loanID model.LoanID, param model.BillingParam) error
+	// CreateBillings stores the billing schedule generated by the domain
+	CreateBillings(loanID model.LoanID, billings []model.Billing) error
 }
 
+// BillingGetter only returns the billings scheduled by the current terms of the loan
 type BillingGetter interface {
-	GetUnfulfilledBillingAt(loanID model.LoanID, when time.Time) ([]model.Billing, error)
+	GetBillings(loanID model.LoanID) ([]model.Billing, error)
+	// GetUnfulfilledBillingUntil returns the unpaid billings due on or before `dueUntil`, oldest first
+	GetUnfulfilledBillingUntil(loanID model.LoanID, dueUntil time.Time) ([]model.Billing, error)
 }
 
 type BillingUpdater interface {
-	PayBillingUntil(loanID model.LoanID, when time.Time) error
+	// UpdateBillings stores the amounts (what is due and what has been paid) and the deferral of the billings, matched
+	// by terms version and term number
+	UpdateBillings(loanID model.LoanID, billings []model.Billing) error
+	// SupersedeBillings closes the unpaid billings scheduled by the terms version, they are kept for the history
+	SupersedeBillings(loanID model.LoanID, termsVersion int, at time.Time) error
+}
+
+type EndOfDayRunGetter interface {
+	// GetEndOfDayRun locks the run when running inside a transaction
+	GetEndOfDayRun(runKey string) (model.EndOfDayRun, error)
+}
+
+type EndOfDayRunCreator interface {
+	// CreateEndOfDayRun fails with `model.ErrEndOfDayRunInProgress` when the business date has a run already
+	CreateEndOfDayRun(run model.EndOfDayRun) error
+}
+
+type EndOfDayRunUpdater interface {
+	// UpdateEndOfDayRun overwrites the checkpoint of the run
+	UpdateEndOfDayRun(run model.EndOfDayRun) error
+}
+
+// LoanStorage is every storage port a loan use case may touch
+type LoanStorage interface {
+	LoanCreator
+	LoanGetter
+	LoanLister
+	LoanUpdater
+	LoanTermsInserter
+	LoanTermsGetter
+	DelinquencyStatusCreator
+	DelinquencyStatusGetter
+	DelinquencyStatusUpdater
+	DelinquencyEventInserter
+	DelinquencyEventGetter
+	DeferralEventInserter
+	DeferralEventGetter
+	WriteOffCreator
+	WriteOffGetter
+	PaymentInserter
+	PaymentGetter
+	PaymentUpdater
+	BillingInserter
+	BillingGetter
+	BillingUpdater
+	EndOfDayRunGetter
+	EndOfDayRunCreator
+	EndOfDayRunUpdater
+}
+
+// UnitOfWork runs `fn` as a single transaction: every write made through `tx` is committed together when `fn`
+// returns nil, or rolled back when it returns an error
+type UnitOfWork interface {
+	WithinTx(fn func(tx LoanStorage) error) error
 }
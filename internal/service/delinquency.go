@@ -0,0 +1,31 @@
+package service
+
+import (
+	"github.com/bahrunnur/loan-billing-service/internal/config"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+)
+
+// delinquencyPolicyFrom builds the delinquency policy of the new loans, a product only overrides what is set for it
+func delinquencyPolicyFrom(serviceConfig config.ServiceConfig) model.DelinquencyPolicy {
+	policy := model.DelinquencyPolicy{
+		DelinquencyRule: model.DelinquencyRule{
+			MissedPaymentThreshold: serviceConfig.MissedPaymentThreshold,
+			GraceDays:              serviceConfig.DelinquencyGraceDays,
+		},
+		Products: map[string]model.DelinquencyRule{},
+	}
+
+	for product, threshold := range serviceConfig.ProductMissedPaymentThreshold {
+		rule := policy.RuleOf(product)
+		rule.MissedPaymentThreshold = threshold
+		policy.Products[product] = rule
+	}
+
+	for product, graceDays := range serviceConfig.ProductDelinquencyGraceDays {
+		rule := policy.RuleOf(product)
+		rule.GraceDays = graceDays
+		policy.Products[product] = rule
+	}
+
+	return policy
+}
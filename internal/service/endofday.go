@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/internal/ports"
+	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
+	"go.uber.org/zap"
+)
+
+// scheduleEndOfDay runs the end-of-day batch of the previous business date every day at `runAt` (UTC) until ctx is
+// done, following the same clock as the loan service. Once `runAt` has passed the batch is run right away, which does
+// nothing when the run is finished already or resumes an interrupted one
+func scheduleEndOfDay(ctx context.Context, clock ports.Clock, loanService *loan.LoanService, runAt time.Time) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	for {
+		now := clock.Now().UTC()
+		next := time.Date(now.Year(), now.Month(), now.Day(), runAt.Hour(), runAt.Minute(), 0, 0, time.UTC)
+
+		if !now.Before(next) {
+			runEndOfDay(ctx, loanService, now.AddDate(0, 0, -1))
+			next = next.AddDate(0, 0, 1)
+		}
+
+		logger.Info("end-of-day batch scheduled",
+			zap.Time("next_run", next),
+		)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next.Sub(now)):
+		}
+	}
+}
+
+func runEndOfDay(ctx context.Context, loanService *loan.LoanService, businessDate time.Time) {
+	logger := o11y.LoggerFromContext(ctx).With(
+		zap.String("run_key", model.EndOfDayRunKey(businessDate)),
+	)
+
+	run, err := loanService.RunEndOfDay(ctx, businessDate, func(result model.EndOfDayResult) {
+		logger.Debug("loan swept",
+			zap.String("loan_id", result.LoanID.String()),
+			zap.Bool("is_delinquent", result.IsDelinquent),
+			zap.Stringer("charged", result.Charged),
+			zap.Int("days_past_due", result.Aging.DaysPastDue),
+			zap.String("bucket", string(result.Aging.Bucket)),
+		)
+	})
+	if err != nil {
+		logger.Error("end-of-day batch interrupted",
+			zap.Error(err),
+			zap.Int("processed", run.Processed),
+		)
+		return
+	}
+
+	logger.Info("end-of-day batch finished",
+		zap.Time("started_at", run.StartedAt),
+		zap.Time("finished_at", run.FinishedAt),
+		zap.Int("processed", run.Processed),
+		zap.Int("delinquent", run.Delinquent),
+		zap.Stringer("charged", run.Charged),
+	)
+}
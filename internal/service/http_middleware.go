@@ -0,0 +1,44 @@
+package service
+
+import (
+	"net/http"
+
+	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
+	"go.uber.org/zap"
+)
+
+// statusRecorder captures the status code written by the handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func httpLoggingMiddleware(logger *zap.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger.Info("Incoming HTTP request",
+			zap.String("method", r.Method),
+			zap.String("path", r.URL.Path),
+		)
+
+		ctx := o11y.SetLogger(r.Context(), logger)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(ctx))
+
+		if rec.status >= http.StatusBadRequest {
+			logger.Error("HTTP request failed",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", rec.status))
+		} else {
+			logger.Info("HTTP request succeeded",
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", rec.status))
+		}
+	})
+}
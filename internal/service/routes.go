@@ -1,3 +1,38 @@
 package service
 
-// TODO: if need http REST API
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/httphandler"
+	"github.com/bahrunnur/loan-billing-service/internal/config"
+	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
+	"go.uber.org/zap"
+)
+
+// serveHTTP serves the REST api under `BASE_PATH` until ctx is done
+func serveHTTP(ctx context.Context, serviceConfig config.ServiceConfig, httpHandler *httphandler.LoanBillingHTTPHandler) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	s := &http.Server{
+		Addr:              fmt.Sprintf(":%d", serviceConfig.Port),
+		Handler:           httpLoggingMiddleware(logger, httpHandler.Routes(serviceConfig.BasePath)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		logger.Info("stopping http server")
+		s.Shutdown(context.Background())
+	}()
+
+	logger.Info(fmt.Sprintf("http server listening at %s%s", s.Addr, serviceConfig.BasePath))
+	if err := s.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatal("fail to serve http endpoint",
+			zap.Error(err),
+		)
+	}
+}
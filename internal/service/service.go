@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/bahrunnur/loan-billing-service/internal/adapters/grpchandler"
-	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/httphandler"
 	"github.com/bahrunnur/loan-billing-service/internal/config"
 	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/internal/model"
+	"github.com/bahrunnur/loan-billing-service/pkg/clock"
+	"github.com/bahrunnur/loan-billing-service/pkg/currency"
 	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
 	v1 "github.com/bahrunnur/loan-billing-service/proto/gen/loanbilling/v1"
 	"go.uber.org/zap"
@@ -19,9 +23,93 @@ func Run(ctx context.Context, serviceConfig config.ServiceConfig) {
 	logger := o11y.LoggerFromContext(ctx)
 
 	// service
-	storage := memorystorage.NewLoanMemoryStorage()
-	loanService := loan.NewLoanService(storage)
-	grpcHandler := grpchandler.NewLoanBillingGRPCServer(loanService)
+	storage, err := newLoanStorage(ctx, serviceConfig)
+	if err != nil {
+		logger.Error("fail to initialize storage",
+			zap.Error(err),
+		)
+		return
+	}
+
+	rebateRule := model.RebateRule(serviceConfig.InterestRebateRule)
+	if !rebateRule.IsValid() {
+		logger.Error("unknown interest rebate rule",
+			zap.String("interest_rebate_rule", serviceConfig.InterestRebateRule),
+		)
+		return
+	}
+
+	rounding := model.RoundingMode(serviceConfig.RoundingMode)
+	if !rounding.IsValid() {
+		logger.Error("unknown rounding mode",
+			zap.String("rounding_mode", serviceConfig.RoundingMode),
+		)
+		return
+	}
+
+	lateFeePolicy := model.LateFeePolicy{
+		GraceDays:        serviceConfig.LateFeeGraceDays,
+		FixedFee:         currency.NewRupiah(serviceConfig.LateFeeFixed, 0),
+		OverdueRate:      model.BPS(serviceConfig.LateFeeOverdueBps),
+		DailyPenaltyRate: model.BPS(serviceConfig.LatePenaltyDailyBps),
+		Cap:              currency.NewRupiah(serviceConfig.LateFeeCap, 0),
+	}
+	if !lateFeePolicy.IsValid() {
+		logger.Error("negative late fee policy",
+			zap.Any("late_fee_policy", lateFeePolicy),
+		)
+		return
+	}
+
+	delinquencyPolicy := delinquencyPolicyFrom(serviceConfig)
+	if !delinquencyPolicy.IsValid() {
+		logger.Error("negative delinquency policy",
+			zap.Any("delinquency_policy", delinquencyPolicy),
+		)
+		return
+	}
+
+	datingWindow := model.DatingWindow{
+		BackdateDays:    serviceConfig.LoanBackdateDays,
+		ForwardDateDays: serviceConfig.LoanForwardDateDays,
+	}
+	if !datingWindow.IsValid() {
+		logger.Error("negative loan dating window",
+			zap.Any("dating_window", datingWindow),
+		)
+		return
+	}
+
+	var endOfDayRunAt time.Time
+	if serviceConfig.EndOfDayRunAt != "" {
+		endOfDayRunAt, err = time.Parse("15:04", serviceConfig.EndOfDayRunAt)
+		if err != nil {
+			logger.Error("invalid end-of-day schedule",
+				zap.String("end_of_day_run_at", serviceConfig.EndOfDayRunAt),
+				zap.Error(err),
+			)
+			return
+		}
+	}
+
+	wallClock := clock.Wall{}
+
+	loanService := loan.NewLoanService(storage,
+		loan.WithClock(wallClock),
+		loan.WithRebateRule(rebateRule),
+		loan.WithRounding(rounding),
+		loan.WithLateFeePolicy(lateFeePolicy),
+		loan.WithDelinquencyPolicy(delinquencyPolicy),
+		loan.WithDatingWindow(datingWindow),
+	)
+	grpcHandler := grpchandler.NewLoanBillingGRPCServer(loanService, grpchandler.WithClock(wallClock))
+	httpHandler := httphandler.NewLoanBillingHTTPHandler(loanService, httphandler.WithClock(wallClock))
+
+	go serveHTTP(ctx, serviceConfig, httpHandler)
+
+	if serviceConfig.EndOfDayRunAt != "" {
+		go scheduleEndOfDay(ctx, wallClock, loanService, endOfDayRunAt)
+	}
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", serviceConfig.GRPCPort))
 	if err != nil {
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/memorystorage"
+	"github.com/bahrunnur/loan-billing-service/internal/adapters/sqlstorage"
+	"github.com/bahrunnur/loan-billing-service/internal/config"
+	"github.com/bahrunnur/loan-billing-service/internal/loan"
+	"github.com/bahrunnur/loan-billing-service/pkg/o11y"
+)
+
+// newLoanStorage picks the storage adapter based on `STORAGE_DRIVER`
+func newLoanStorage(ctx context.Context, serviceConfig config.ServiceConfig) (loan.LoanStorageAdapter, error) {
+	logger := o11y.LoggerFromContext(ctx)
+
+	switch serviceConfig.StorageDriver {
+	case "memory":
+		logger.Warn("using memory storage, loans will be lost on restart")
+		return memorystorage.NewLoanMemoryStorage(), nil
+	case "postgres":
+		db, err := sqlstorage.Open(serviceConfig.DatabaseURL)
+		if err != nil {
+			return nil, err
+		}
+
+		go func() {
+			<-ctx.Done()
+			logger.Info("closing database connection")
+			db.Close()
+		}()
+
+		return sqlstorage.NewLoanSQLStorage(db), nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %q", serviceConfig.StorageDriver)
+	}
+}
@@ -0,0 +1,11 @@
+// Package clock tells the current time
+package clock
+
+import "time"
+
+// Wall is the wall clock, in UTC
+type Wall struct{}
+
+func (Wall) Now() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,53 @@
+// Package clocktest provides a controllable clock for the tests
+package clocktest
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock only moves when it is told to, it is safe to share between goroutines
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock creates a clock stopped at `now`
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now.UTC()}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set moves the clock to `now`, back in time as well
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now.UTC()
+}
+
+// Advance moves the clock forward by `d`
+func (c *Clock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	return c.now
+}
+
+// AdvanceDays moves the clock forward by calendar days
+func (c *Clock) AdvanceDays(days int) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.AddDate(0, 0, days)
+
+	return c.now
+}
@@ -21,445 +21,5094 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetOutstandingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// what to do with a payment that doesn't match the due billings, partial payment is always applied to the oldest
+// billing first
+type AllocationPolicy int32
 
-	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-}
+const (
+	AllocationPolicy_ALLOCATION_POLICY_UNSPECIFIED     AllocationPolicy = 0 // default to apply to future
+	AllocationPolicy_ALLOCATION_POLICY_APPLY_TO_FUTURE AllocationPolicy = 1
+	AllocationPolicy_ALLOCATION_POLICY_HOLD_AS_CREDIT  AllocationPolicy = 2
+	AllocationPolicy_ALLOCATION_POLICY_EXACT_DUE       AllocationPolicy = 3
+)
 
-func (x *GetOutstandingRequest) Reset() {
-	*x = GetOutstandingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for AllocationPolicy.
+var (
+	AllocationPolicy_name = map[int32]string{
+		0: "ALLOCATION_POLICY_UNSPECIFIED",
+		1: "ALLOCATION_POLICY_APPLY_TO_FUTURE",
+		2: "ALLOCATION_POLICY_HOLD_AS_CREDIT",
+		3: "ALLOCATION_POLICY_EXACT_DUE",
+	}
+	AllocationPolicy_value = map[string]int32{
+		"ALLOCATION_POLICY_UNSPECIFIED":     0,
+		"ALLOCATION_POLICY_APPLY_TO_FUTURE": 1,
+		"ALLOCATION_POLICY_HOLD_AS_CREDIT":  2,
+		"ALLOCATION_POLICY_EXACT_DUE":       3,
 	}
+)
+
+func (x AllocationPolicy) Enum() *AllocationPolicy {
+	p := new(AllocationPolicy)
+	*p = x
+	return p
 }
 
-func (x *GetOutstandingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x AllocationPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetOutstandingRequest) ProtoMessage() {}
+func (AllocationPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[0].Descriptor()
+}
 
-func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (AllocationPolicy) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[0]
 }
 
-// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
-func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{0}
+func (x AllocationPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetOutstandingRequest) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
+// Deprecated: Use AllocationPolicy.Descriptor instead.
+func (AllocationPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{0}
 }
 
-type GetOutstandingResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// how often an installment is due
+type Frequency int32
 
-	OutstandingBalance int64  `protobuf:"varint,1,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"`
-	Decimal            int32  `protobuf:"varint,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
-	Currency           string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-}
+const (
+	Frequency_FREQUENCY_UNSPECIFIED Frequency = 0 // default to weekly
+	Frequency_FREQUENCY_WEEKLY      Frequency = 1
+	Frequency_FREQUENCY_BIWEEKLY    Frequency = 2
+	Frequency_FREQUENCY_MONTHLY     Frequency = 3 // due on the same day of month as the start date, clamped to the end of shorter months
+)
 
-func (x *GetOutstandingResponse) Reset() {
-	*x = GetOutstandingResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for Frequency.
+var (
+	Frequency_name = map[int32]string{
+		0: "FREQUENCY_UNSPECIFIED",
+		1: "FREQUENCY_WEEKLY",
+		2: "FREQUENCY_BIWEEKLY",
+		3: "FREQUENCY_MONTHLY",
 	}
+	Frequency_value = map[string]int32{
+		"FREQUENCY_UNSPECIFIED": 0,
+		"FREQUENCY_WEEKLY":      1,
+		"FREQUENCY_BIWEEKLY":    2,
+		"FREQUENCY_MONTHLY":     3,
+	}
+)
+
+func (x Frequency) Enum() *Frequency {
+	p := new(Frequency)
+	*p = x
+	return p
 }
 
-func (x *GetOutstandingResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Frequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetOutstandingResponse) ProtoMessage() {}
+func (Frequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[1].Descriptor()
+}
 
-func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Frequency) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[1]
 }
 
-// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
-func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{1}
+func (x Frequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetOutstandingResponse) GetOutstandingBalance() int64 {
-	if x != nil {
-		return x.OutstandingBalance
-	}
-	return 0
+// Deprecated: Use Frequency.Descriptor instead.
+func (Frequency) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetOutstandingResponse) GetDecimal() int32 {
-	if x != nil {
-		return x.Decimal
+// how the interest of every term is computed
+type InterestMethod int32
+
+const (
+	InterestMethod_INTEREST_METHOD_UNSPECIFIED       InterestMethod = 0 // default to flat
+	InterestMethod_INTEREST_METHOD_FLAT              InterestMethod = 1
+	InterestMethod_INTEREST_METHOD_DECLINING_BALANCE InterestMethod = 2
+	InterestMethod_INTEREST_METHOD_ANNUITY           InterestMethod = 3
+)
+
+// Enum value maps for InterestMethod.
+var (
+	InterestMethod_name = map[int32]string{
+		0: "INTEREST_METHOD_UNSPECIFIED",
+		1: "INTEREST_METHOD_FLAT",
+		2: "INTEREST_METHOD_DECLINING_BALANCE",
+		3: "INTEREST_METHOD_ANNUITY",
 	}
-	return 0
+	InterestMethod_value = map[string]int32{
+		"INTEREST_METHOD_UNSPECIFIED":       0,
+		"INTEREST_METHOD_FLAT":              1,
+		"INTEREST_METHOD_DECLINING_BALANCE": 2,
+		"INTEREST_METHOD_ANNUITY":           3,
+	}
+)
+
+func (x InterestMethod) Enum() *InterestMethod {
+	p := new(InterestMethod)
+	*p = x
+	return p
 }
 
-func (x *GetOutstandingResponse) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+func (x InterestMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type IsDelinquentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (InterestMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[2].Descriptor()
+}
 
-	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+func (InterestMethod) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[2]
 }
 
-func (x *IsDelinquentRequest) Reset() {
-	*x = IsDelinquentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x InterestMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *IsDelinquentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use InterestMethod.Descriptor instead.
+func (InterestMethod) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{2}
 }
 
-func (*IsDelinquentRequest) ProtoMessage() {}
+// what annual_interest_rate_bps is quoted over
+type RateBasis int32
 
-func (x *IsDelinquentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	RateBasis_RATE_BASIS_UNSPECIFIED RateBasis = 0 // default to annual
+	RateBasis_RATE_BASIS_ANNUAL      RateBasis = 1 // nominal annual rate, prorated to every term by the day count
+	RateBasis_RATE_BASIS_PER_TENOR   RateBasis = 2 // flat over the whole loan whatever its length
+	RateBasis_RATE_BASIS_MONTHLY     RateBasis = 3 // flat per month
+)
+
+// Enum value maps for RateBasis.
+var (
+	RateBasis_name = map[int32]string{
+		0: "RATE_BASIS_UNSPECIFIED",
+		1: "RATE_BASIS_ANNUAL",
+		2: "RATE_BASIS_PER_TENOR",
+		3: "RATE_BASIS_MONTHLY",
 	}
-	return mi.MessageOf(x)
-}
+	RateBasis_value = map[string]int32{
+		"RATE_BASIS_UNSPECIFIED": 0,
+		"RATE_BASIS_ANNUAL":      1,
+		"RATE_BASIS_PER_TENOR":   2,
+		"RATE_BASIS_MONTHLY":     3,
+	}
+)
 
-// Deprecated: Use IsDelinquentRequest.ProtoReflect.Descriptor instead.
-func (*IsDelinquentRequest) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{2}
+func (x RateBasis) Enum() *RateBasis {
+	p := new(RateBasis)
+	*p = x
+	return p
 }
 
-func (x *IsDelinquentRequest) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
+func (x RateBasis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type IsDelinquentResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (RateBasis) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[3].Descriptor()
+}
 
-	IsDelinquent bool `protobuf:"varint,1,opt,name=is_delinquent,json=isDelinquent,proto3" json:"is_delinquent,omitempty"`
+func (RateBasis) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[3]
 }
 
-func (x *IsDelinquentResponse) Reset() {
-	*x = IsDelinquentResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x RateBasis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *IsDelinquentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use RateBasis.Descriptor instead.
+func (RateBasis) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{3}
 }
 
-func (*IsDelinquentResponse) ProtoMessage() {}
+// days past due (DPD) bucket
+type Bucket int32
 
-func (x *IsDelinquentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	Bucket_BUCKET_UNSPECIFIED Bucket = 0
+	Bucket_BUCKET_CURRENT     Bucket = 1
+	Bucket_BUCKET_1_30        Bucket = 2
+	Bucket_BUCKET_31_60       Bucket = 3
+	Bucket_BUCKET_61_90       Bucket = 4
+	Bucket_BUCKET_90_PLUS     Bucket = 5
+)
+
+// Enum value maps for Bucket.
+var (
+	Bucket_name = map[int32]string{
+		0: "BUCKET_UNSPECIFIED",
+		1: "BUCKET_CURRENT",
+		2: "BUCKET_1_30",
+		3: "BUCKET_31_60",
+		4: "BUCKET_61_90",
+		5: "BUCKET_90_PLUS",
 	}
-	return mi.MessageOf(x)
-}
+	Bucket_value = map[string]int32{
+		"BUCKET_UNSPECIFIED": 0,
+		"BUCKET_CURRENT":     1,
+		"BUCKET_1_30":        2,
+		"BUCKET_31_60":       3,
+		"BUCKET_61_90":       4,
+		"BUCKET_90_PLUS":     5,
+	}
+)
 
-// Deprecated: Use IsDelinquentResponse.ProtoReflect.Descriptor instead.
-func (*IsDelinquentResponse) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{3}
+func (x Bucket) Enum() *Bucket {
+	p := new(Bucket)
+	*p = x
+	return p
 }
 
-func (x *IsDelinquentResponse) GetIsDelinquent() bool {
-	if x != nil {
-		return x.IsDelinquent
-	}
-	return false
+func (x Bucket) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type MakePaymentRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (Bucket) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[4].Descriptor()
+}
 
-	LoanId   string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
-	Amount   int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Decimal  int32                  `protobuf:"varint,3,opt,name=decimal,proto3" json:"decimal,omitempty"`
-	Currency string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	When     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"`
+func (Bucket) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[4]
 }
 
-func (x *MakePaymentRequest) Reset() {
-	*x = MakePaymentRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x Bucket) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *MakePaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Bucket.Descriptor instead.
+func (Bucket) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{4}
 }
 
-func (*MakePaymentRequest) ProtoMessage() {}
+type DelinquencyEventKind int32
 
-func (x *MakePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	DelinquencyEventKind_DELINQUENCY_EVENT_KIND_UNSPECIFIED DelinquencyEventKind = 0
+	DelinquencyEventKind_DELINQUENCY_EVENT_KIND_DELINQUENT  DelinquencyEventKind = 1
+	DelinquencyEventKind_DELINQUENCY_EVENT_KIND_CURED       DelinquencyEventKind = 2
+)
+
+// Enum value maps for DelinquencyEventKind.
+var (
+	DelinquencyEventKind_name = map[int32]string{
+		0: "DELINQUENCY_EVENT_KIND_UNSPECIFIED",
+		1: "DELINQUENCY_EVENT_KIND_DELINQUENT",
+		2: "DELINQUENCY_EVENT_KIND_CURED",
 	}
-	return mi.MessageOf(x)
+	DelinquencyEventKind_value = map[string]int32{
+		"DELINQUENCY_EVENT_KIND_UNSPECIFIED": 0,
+		"DELINQUENCY_EVENT_KIND_DELINQUENT":  1,
+		"DELINQUENCY_EVENT_KIND_CURED":       2,
+	}
+)
+
+func (x DelinquencyEventKind) Enum() *DelinquencyEventKind {
+	p := new(DelinquencyEventKind)
+	*p = x
+	return p
 }
 
-// Deprecated: Use MakePaymentRequest.ProtoReflect.Descriptor instead.
-func (*MakePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{4}
+func (x DelinquencyEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *MakePaymentRequest) GetLoanId() string {
-	if x != nil {
-		return x.LoanId
-	}
-	return ""
+func (DelinquencyEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[5].Descriptor()
 }
 
-func (x *MakePaymentRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (DelinquencyEventKind) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[5]
 }
 
-func (x *MakePaymentRequest) GetDecimal() int32 {
-	if x != nil {
-		return x.Decimal
-	}
-	return 0
+func (x DelinquencyEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *MakePaymentRequest) GetCurrency() string {
-	if x != nil {
-		return x.Currency
-	}
-	return ""
+// Deprecated: Use DelinquencyEventKind.Descriptor instead.
+func (DelinquencyEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *MakePaymentRequest) GetWhen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.When
+type PaymentKind int32
+
+const (
+	PaymentKind_PAYMENT_KIND_UNSPECIFIED PaymentKind = 0
+	PaymentKind_PAYMENT_KIND_REPAYMENT   PaymentKind = 1
+	PaymentKind_PAYMENT_KIND_RECOVERY    PaymentKind = 2 // collected after the write-off
+)
+
+// Enum value maps for PaymentKind.
+var (
+	PaymentKind_name = map[int32]string{
+		0: "PAYMENT_KIND_UNSPECIFIED",
+		1: "PAYMENT_KIND_REPAYMENT",
+		2: "PAYMENT_KIND_RECOVERY",
 	}
-	return nil
+	PaymentKind_value = map[string]int32{
+		"PAYMENT_KIND_UNSPECIFIED": 0,
+		"PAYMENT_KIND_REPAYMENT":   1,
+		"PAYMENT_KIND_RECOVERY":    2,
+	}
+)
+
+func (x PaymentKind) Enum() *PaymentKind {
+	p := new(PaymentKind)
+	*p = x
+	return p
 }
 
-type MakePaymentResponse struct {
+func (x PaymentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[6].Descriptor()
+}
+
+func (PaymentKind) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[6]
+}
+
+func (x PaymentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentKind.Descriptor instead.
+func (PaymentKind) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{6}
+}
+
+type DayCount int32
+
+const (
+	DayCount_DAY_COUNT_UNSPECIFIED DayCount = 0 // default to ACT/365
+	DayCount_DAY_COUNT_ACT_365     DayCount = 1
+	DayCount_DAY_COUNT_30E_360     DayCount = 2
+)
+
+// Enum value maps for DayCount.
+var (
+	DayCount_name = map[int32]string{
+		0: "DAY_COUNT_UNSPECIFIED",
+		1: "DAY_COUNT_ACT_365",
+		2: "DAY_COUNT_30E_360",
+	}
+	DayCount_value = map[string]int32{
+		"DAY_COUNT_UNSPECIFIED": 0,
+		"DAY_COUNT_ACT_365":     1,
+		"DAY_COUNT_30E_360":     2,
+	}
+)
+
+func (x DayCount) Enum() *DayCount {
+	p := new(DayCount)
+	*p = x
+	return p
+}
+
+func (x DayCount) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayCount) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[7].Descriptor()
+}
+
+func (DayCount) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[7]
+}
+
+func (x DayCount) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayCount.Descriptor instead.
+func (DayCount) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{7}
+}
+
+// where the installments deferred by a payment holiday go
+type DeferralMethod int32
+
+const (
+	DeferralMethod_DEFERRAL_METHOD_UNSPECIFIED  DeferralMethod = 0 // default to extend the tenor
+	DeferralMethod_DEFERRAL_METHOD_EXTEND_TENOR DeferralMethod = 1 // every installment from the first deferred one is due later, the tenor grows
+	DeferralMethod_DEFERRAL_METHOD_SPREAD       DeferralMethod = 2 // spread over the installments after the holiday
+)
+
+// Enum value maps for DeferralMethod.
+var (
+	DeferralMethod_name = map[int32]string{
+		0: "DEFERRAL_METHOD_UNSPECIFIED",
+		1: "DEFERRAL_METHOD_EXTEND_TENOR",
+		2: "DEFERRAL_METHOD_SPREAD",
+	}
+	DeferralMethod_value = map[string]int32{
+		"DEFERRAL_METHOD_UNSPECIFIED":  0,
+		"DEFERRAL_METHOD_EXTEND_TENOR": 1,
+		"DEFERRAL_METHOD_SPREAD":       2,
+	}
+)
+
+func (x DeferralMethod) Enum() *DeferralMethod {
+	p := new(DeferralMethod)
+	*p = x
+	return p
+}
+
+func (x DeferralMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeferralMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[8].Descriptor()
+}
+
+func (DeferralMethod) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[8]
+}
+
+func (x DeferralMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeferralMethod.Descriptor instead.
+func (DeferralMethod) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{8}
+}
+
+// how much of the unearned flat interest is given back on early settlement
+type RebateRule int32
+
+const (
+	RebateRule_REBATE_RULE_UNSPECIFIED RebateRule = 0
+	RebateRule_REBATE_RULE_NONE        RebateRule = 1
+	RebateRule_REBATE_RULE_PRO_RATA    RebateRule = 2
+	RebateRule_REBATE_RULE_RULE_OF_78  RebateRule = 3
+)
+
+// Enum value maps for RebateRule.
+var (
+	RebateRule_name = map[int32]string{
+		0: "REBATE_RULE_UNSPECIFIED",
+		1: "REBATE_RULE_NONE",
+		2: "REBATE_RULE_PRO_RATA",
+		3: "REBATE_RULE_RULE_OF_78",
+	}
+	RebateRule_value = map[string]int32{
+		"REBATE_RULE_UNSPECIFIED": 0,
+		"REBATE_RULE_NONE":        1,
+		"REBATE_RULE_PRO_RATA":    2,
+		"REBATE_RULE_RULE_OF_78":  3,
+	}
+)
+
+func (x RebateRule) Enum() *RebateRule {
+	p := new(RebateRule)
+	*p = x
+	return p
+}
+
+func (x RebateRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RebateRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_loanbilling_v1_loanbilling_proto_enumTypes[9].Descriptor()
+}
+
+func (RebateRule) Type() protoreflect.EnumType {
+	return &file_loanbilling_v1_loanbilling_proto_enumTypes[9]
+}
+
+func (x RebateRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RebateRule.Descriptor instead.
+func (RebateRule) EnumDescriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{9}
+}
+
+type Money struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Amount   int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimal  int32  `protobuf:"varint,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	Currency string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
 }
 
-func (x *MakePaymentResponse) Reset() {
-	*x = MakePaymentResponse{}
+func (x *Money) Reset() {
+	*x = Money{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[5]
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakePaymentResponse) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakePaymentResponse) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *MakePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[5]
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetDecimal() int32 {
+	if x != nil {
+		return x.Decimal
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type Loan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Principal             *Money                 `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	AnnualInterestRateBps int32                  `protobuf:"varint,3,opt,name=annual_interest_rate_bps,json=annualInterestRateBps,proto3" json:"annual_interest_rate_bps,omitempty"` // basis point (1 basis point = 0.01%), over rate_basis
+	StartDate             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	TotalInterest         *Money                 `protobuf:"bytes,5,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	OutstandingBalance    *Money                 `protobuf:"bytes,6,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"`
+	IsCompleted           bool                   `protobuf:"varint,7,opt,name=is_completed,json=isCompleted,proto3" json:"is_completed,omitempty"`
+	// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+	LoanTermWeeks int32 `protobuf:"varint,8,opt,name=loan_term_weeks,json=loanTermWeeks,proto3" json:"loan_term_weeks,omitempty"` // only set for weekly loans, use loan_term
+	// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+	WeeklyPayment *Money `protobuf:"bytes,9,opt,name=weekly_payment,json=weeklyPayment,proto3" json:"weekly_payment,omitempty"` // only set for weekly loans, use installment_amount
+	// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+	WeeklyInterest      *Money                 `protobuf:"bytes,10,opt,name=weekly_interest,json=weeklyInterest,proto3" json:"weekly_interest,omitempty"` // only set for weekly loans, use installment_interest
+	AllocationPolicy    AllocationPolicy       `protobuf:"varint,11,opt,name=allocation_policy,json=allocationPolicy,proto3,enum=loanbilling.v1.AllocationPolicy" json:"allocation_policy,omitempty"`
+	Credit              *Money                 `protobuf:"bytes,12,opt,name=credit,proto3" json:"credit,omitempty"`
+	Frequency           Frequency              `protobuf:"varint,13,opt,name=frequency,proto3,enum=loanbilling.v1.Frequency" json:"frequency,omitempty"`
+	LoanTerm            int32                  `protobuf:"varint,14,opt,name=loan_term,json=loanTerm,proto3" json:"loan_term,omitempty"`                                 // number of installments
+	InstallmentAmount   *Money                 `protobuf:"bytes,15,opt,name=installment_amount,json=installmentAmount,proto3" json:"installment_amount,omitempty"`       // of the first term, see the billings for the rest
+	InstallmentInterest *Money                 `protobuf:"bytes,16,opt,name=installment_interest,json=installmentInterest,proto3" json:"installment_interest,omitempty"` // of the first term, see the billings for the rest
+	InterestMethod      InterestMethod         `protobuf:"varint,17,opt,name=interest_method,json=interestMethod,proto3,enum=loanbilling.v1.InterestMethod" json:"interest_method,omitempty"`
+	RateBasis           RateBasis              `protobuf:"varint,18,opt,name=rate_basis,json=rateBasis,proto3,enum=loanbilling.v1.RateBasis" json:"rate_basis,omitempty"`
+	DayCount            DayCount               `protobuf:"varint,19,opt,name=day_count,json=dayCount,proto3,enum=loanbilling.v1.DayCount" json:"day_count,omitempty"`
+	Product             string                 `protobuf:"bytes,20,opt,name=product,proto3" json:"product,omitempty"`
+	DelinquencyRule     *DelinquencyRule       `protobuf:"bytes,21,opt,name=delinquency_rule,json=delinquencyRule,proto3" json:"delinquency_rule,omitempty"` // snapshot of the delinquency policy at creation
+	FirstDueDate        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=first_due_date,json=firstDueDate,proto3" json:"first_due_date,omitempty"`        // unset when one installment after the start date
+	TermsVersion        int32                  `protobuf:"varint,23,opt,name=terms_version,json=termsVersion,proto3" json:"terms_version,omitempty"`         // 1 until the loan is restructured
+	WrittenOffAt        *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=written_off_at,json=writtenOffAt,proto3" json:"written_off_at,omitempty"`        // unset unless written off
+}
+
+func (x *Loan) Reset() {
+	*x = Loan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loan) ProtoMessage() {}
+
+func (x *Loan) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MakePaymentResponse.ProtoReflect.Descriptor instead.
-func (*MakePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{5}
-}
-
-var File_loanbilling_v1_loanbilling_proto protoreflect.FileDescriptor
-
-var file_loanbilling_v1_loanbilling_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
-	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
-	0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73,
-	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2f, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x75,
-	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x2e, 0x0a, 0x13, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69,
-	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x14, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69,
-	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
-	0x65, 0x6e, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
-	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61,
-	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64,
-	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65,
-	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x77, 0x68, 0x65,
-	0x6e, 0x22, 0x15, 0x0a, 0x13, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xae, 0x02, 0x0a, 0x12, 0x4c, 0x6f, 0x61,
-	0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
-	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74,
-	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65,
-	0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e,
-	0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x58, 0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
-	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0xc7, 0x01, 0x0a, 0x12, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
-	0x42, 0x10, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x62, 0x61, 0x68, 0x72, 0x75, 0x6e, 0x6e, 0x75, 0x72, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2d,
-	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c,
-	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c,
-	0x58, 0x58, 0xaa, 0x02, 0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
-	0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_loanbilling_v1_loanbilling_proto_rawDescOnce sync.Once
-	file_loanbilling_v1_loanbilling_proto_rawDescData = file_loanbilling_v1_loanbilling_proto_rawDesc
-)
-
-func file_loanbilling_v1_loanbilling_proto_rawDescGZIP() []byte {
-	file_loanbilling_v1_loanbilling_proto_rawDescOnce.Do(func() {
-		file_loanbilling_v1_loanbilling_proto_rawDescData = protoimpl.X.CompressGZIP(file_loanbilling_v1_loanbilling_proto_rawDescData)
-	})
-	return file_loanbilling_v1_loanbilling_proto_rawDescData
-}
-
-var file_loanbilling_v1_loanbilling_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_loanbilling_v1_loanbilling_proto_goTypes = []any{
-	(*GetOutstandingRequest)(nil),  // 0: loanbilling.v1.GetOutstandingRequest
-	(*GetOutstandingResponse)(nil), // 1: loanbilling.v1.GetOutstandingResponse
-	(*IsDelinquentRequest)(nil),    // 2: loanbilling.v1.IsDelinquentRequest
-	(*IsDelinquentResponse)(nil),   // 3: loanbilling.v1.IsDelinquentResponse
-	(*MakePaymentRequest)(nil),     // 4: loanbilling.v1.MakePaymentRequest
-	(*MakePaymentResponse)(nil),    // 5: loanbilling.v1.MakePaymentResponse
-	(*timestamppb.Timestamp)(nil),  // 6: google.protobuf.Timestamp
-}
-var file_loanbilling_v1_loanbilling_proto_depIdxs = []int32{
-	6, // 0: loanbilling.v1.MakePaymentRequest.when:type_name -> google.protobuf.Timestamp
-	0, // 1: loanbilling.v1.LoanBillingService.GetOutstanding:input_type -> loanbilling.v1.GetOutstandingRequest
-	2, // 2: loanbilling.v1.LoanBillingService.IsDelinquent:input_type -> loanbilling.v1.IsDelinquentRequest
-	4, // 3: loanbilling.v1.LoanBillingService.MakePayment:input_type -> loanbilling.v1.MakePaymentRequest
-	1, // 4: loanbilling.v1.LoanBillingService.GetOutstanding:output_type -> loanbilling.v1.GetOutstandingResponse
-	3, // 5: loanbilling.v1.LoanBillingService.IsDelinquent:output_type -> loanbilling.v1.IsDelinquentResponse
-	5, // 6: loanbilling.v1.LoanBillingService.MakePayment:output_type -> loanbilling.v1.MakePaymentResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
-}
-
-func init() { file_loanbilling_v1_loanbilling_proto_init() }
-func file_loanbilling_v1_loanbilling_proto_init() {
-	if File_loanbilling_v1_loanbilling_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_loanbilling_v1_loanbilling_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOutstandingRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loan.ProtoReflect.Descriptor instead.
+func (*Loan) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Loan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Loan) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *Loan) GetAnnualInterestRateBps() int32 {
+	if x != nil {
+		return x.AnnualInterestRateBps
+	}
+	return 0
+}
+
+func (x *Loan) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *Loan) GetTotalInterest() *Money {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return nil
+}
+
+func (x *Loan) GetOutstandingBalance() *Money {
+	if x != nil {
+		return x.OutstandingBalance
+	}
+	return nil
+}
+
+func (x *Loan) GetIsCompleted() bool {
+	if x != nil {
+		return x.IsCompleted
+	}
+	return false
+}
+
+// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+func (x *Loan) GetLoanTermWeeks() int32 {
+	if x != nil {
+		return x.LoanTermWeeks
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+func (x *Loan) GetWeeklyPayment() *Money {
+	if x != nil {
+		return x.WeeklyPayment
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+func (x *Loan) GetWeeklyInterest() *Money {
+	if x != nil {
+		return x.WeeklyInterest
+	}
+	return nil
+}
+
+func (x *Loan) GetAllocationPolicy() AllocationPolicy {
+	if x != nil {
+		return x.AllocationPolicy
+	}
+	return AllocationPolicy_ALLOCATION_POLICY_UNSPECIFIED
+}
+
+func (x *Loan) GetCredit() *Money {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *Loan) GetFrequency() Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Loan) GetLoanTerm() int32 {
+	if x != nil {
+		return x.LoanTerm
+	}
+	return 0
+}
+
+func (x *Loan) GetInstallmentAmount() *Money {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return nil
+}
+
+func (x *Loan) GetInstallmentInterest() *Money {
+	if x != nil {
+		return x.InstallmentInterest
+	}
+	return nil
+}
+
+func (x *Loan) GetInterestMethod() InterestMethod {
+	if x != nil {
+		return x.InterestMethod
+	}
+	return InterestMethod_INTEREST_METHOD_UNSPECIFIED
+}
+
+func (x *Loan) GetRateBasis() RateBasis {
+	if x != nil {
+		return x.RateBasis
+	}
+	return RateBasis_RATE_BASIS_UNSPECIFIED
+}
+
+func (x *Loan) GetDayCount() DayCount {
+	if x != nil {
+		return x.DayCount
+	}
+	return DayCount_DAY_COUNT_UNSPECIFIED
+}
+
+func (x *Loan) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *Loan) GetDelinquencyRule() *DelinquencyRule {
+	if x != nil {
+		return x.DelinquencyRule
+	}
+	return nil
+}
+
+func (x *Loan) GetFirstDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDueDate
+	}
+	return nil
+}
+
+func (x *Loan) GetTermsVersion() int32 {
+	if x != nil {
+		return x.TermsVersion
+	}
+	return 0
+}
+
+func (x *Loan) GetWrittenOffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenOffAt
+	}
+	return nil
+}
+
+type DelinquencyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MissedPaymentThreshold int32 `protobuf:"varint,1,opt,name=missed_payment_threshold,json=missedPaymentThreshold,proto3" json:"missed_payment_threshold,omitempty"` // delinquent once more billings than this are missed
+	GraceDays              int32 `protobuf:"varint,2,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`                                          // days after the due date before a billing is missed
+}
+
+func (x *DelinquencyRule) Reset() {
+	*x = DelinquencyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelinquencyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelinquencyRule) ProtoMessage() {}
+
+func (x *DelinquencyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelinquencyRule.ProtoReflect.Descriptor instead.
+func (*DelinquencyRule) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DelinquencyRule) GetMissedPaymentThreshold() int32 {
+	if x != nil {
+		return x.MissedPaymentThreshold
+	}
+	return 0
+}
+
+func (x *DelinquencyRule) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+type DelinquencyStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDelinquent   bool                   `protobuf:"varint,1,opt,name=is_delinquent,json=isDelinquent,proto3" json:"is_delinquent,omitempty"`
+	LateFee        *Money                 `protobuf:"bytes,2,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`
+	DaysPastDue    int32                  `protobuf:"varint,3,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"` // at aged_at
+	Bucket         Bucket                 `protobuf:"varint,4,opt,name=bucket,proto3,enum=loanbilling.v1.Bucket" json:"bucket,omitempty"`
+	Kolektibilitas int32                  `protobuf:"varint,5,opt,name=kolektibilitas,proto3" json:"kolektibilitas,omitempty"` // OJK 1-5
+	AgedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=aged_at,json=agedAt,proto3" json:"aged_at,omitempty"`
+	DelinquentAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=delinquent_at,json=delinquentAt,proto3" json:"delinquent_at,omitempty"` // the latest time it became delinquent
+	CuredAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=cured_at,json=curedAt,proto3" json:"cured_at,omitempty"`                // the latest time it got cured
+}
+
+func (x *DelinquencyStatus) Reset() {
+	*x = DelinquencyStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelinquencyStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelinquencyStatus) ProtoMessage() {}
+
+func (x *DelinquencyStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelinquencyStatus.ProtoReflect.Descriptor instead.
+func (*DelinquencyStatus) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DelinquencyStatus) GetIsDelinquent() bool {
+	if x != nil {
+		return x.IsDelinquent
+	}
+	return false
+}
+
+func (x *DelinquencyStatus) GetLateFee() *Money {
+	if x != nil {
+		return x.LateFee
+	}
+	return nil
+}
+
+func (x *DelinquencyStatus) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *DelinquencyStatus) GetBucket() Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return Bucket_BUCKET_UNSPECIFIED
+}
+
+func (x *DelinquencyStatus) GetKolektibilitas() int32 {
+	if x != nil {
+		return x.Kolektibilitas
+	}
+	return 0
+}
+
+func (x *DelinquencyStatus) GetAgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AgedAt
+	}
+	return nil
+}
+
+func (x *DelinquencyStatus) GetDelinquentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DelinquentAt
+	}
+	return nil
+}
+
+func (x *DelinquencyStatus) GetCuredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CuredAt
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount        *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceBefore *Money                 `protobuf:"bytes,3,opt,name=balance_before,json=balanceBefore,proto3" json:"balance_before,omitempty"`
+	BalanceAfter  *Money                 `protobuf:"bytes,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	// what the payment has been applied to, see the waterfall in docs/design.md
+	Principal      *Money                 `protobuf:"bytes,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest       *Money                 `protobuf:"bytes,6,opt,name=interest,proto3" json:"interest,omitempty"`
+	Fee            *Money                 `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Penalty        *Money                 `protobuf:"bytes,8,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Id             string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	Reference      string                 `protobuf:"bytes,10,opt,name=reference,proto3" json:"reference,omitempty"`
+	ReversedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=reversed_at,json=reversedAt,proto3" json:"reversed_at,omitempty"` // unset unless reversed, a reversed payment is no longer applied
+	ReversalReason string                 `protobuf:"bytes,12,opt,name=reversal_reason,json=reversalReason,proto3" json:"reversal_reason,omitempty"`
+	Kind           PaymentKind            `protobuf:"varint,13,opt,name=kind,proto3,enum=loanbilling.v1.PaymentKind" json:"kind,omitempty"`
+	Rebate         *Money                 `protobuf:"bytes,14,opt,name=rebate,proto3" json:"rebate,omitempty"` // the unearned interest given back by a settlement, not paid
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Payment) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Payment) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Payment) GetBalanceBefore() *Money {
+	if x != nil {
+		return x.BalanceBefore
+	}
+	return nil
+}
+
+func (x *Payment) GetBalanceAfter() *Money {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return nil
+}
+
+func (x *Payment) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *Payment) GetInterest() *Money {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *Payment) GetFee() *Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Payment) GetPenalty() *Money {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Payment) GetReversedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversedAt
+	}
+	return nil
+}
+
+func (x *Payment) GetReversalReason() string {
+	if x != nil {
+		return x.ReversalReason
+	}
+	return ""
+}
+
+func (x *Payment) GetKind() PaymentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PaymentKind_PAYMENT_KIND_UNSPECIFIED
+}
+
+func (x *Payment) GetRebate() *Money {
+	if x != nil {
+		return x.Rebate
+	}
+	return nil
+}
+
+type Billing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TermNumber     int32                  `protobuf:"varint,1,opt,name=term_number,json=termNumber,proto3" json:"term_number,omitempty"`
+	PaymentDueDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=payment_due_date,json=paymentDueDate,proto3" json:"payment_due_date,omitempty"`
+	Repayment      *Money                 `protobuf:"bytes,3,opt,name=repayment,proto3" json:"repayment,omitempty"`
+	IsPaid         bool                   `protobuf:"varint,4,opt,name=is_paid,json=isPaid,proto3" json:"is_paid,omitempty"`
+	PaidAmount     *Money                 `protobuf:"bytes,5,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`
+	Principal      *Money                 `protobuf:"bytes,6,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest       *Money                 `protobuf:"bytes,7,opt,name=interest,proto3" json:"interest,omitempty"`
+	Fee            *Money                 `protobuf:"bytes,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	Penalty        *Money                 `protobuf:"bytes,9,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	PaidPrincipal  *Money                 `protobuf:"bytes,10,opt,name=paid_principal,json=paidPrincipal,proto3" json:"paid_principal,omitempty"`
+	PaidInterest   *Money                 `protobuf:"bytes,11,opt,name=paid_interest,json=paidInterest,proto3" json:"paid_interest,omitempty"`
+	PaidFee        *Money                 `protobuf:"bytes,12,opt,name=paid_fee,json=paidFee,proto3" json:"paid_fee,omitempty"`
+	PaidPenalty    *Money                 `protobuf:"bytes,13,opt,name=paid_penalty,json=paidPenalty,proto3" json:"paid_penalty,omitempty"`
+	DeferredAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deferred_at,json=deferredAt,proto3" json:"deferred_at,omitempty"` // unset unless deferred by a payment holiday, never missed
+	Rebate         *Money                 `protobuf:"bytes,15,opt,name=rebate,proto3" json:"rebate,omitempty"`                           // the unearned interest given back by a settlement, counted as paid
+}
+
+func (x *Billing) Reset() {
+	*x = Billing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Billing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Billing) ProtoMessage() {}
+
+func (x *Billing) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Billing.ProtoReflect.Descriptor instead.
+func (*Billing) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Billing) GetTermNumber() int32 {
+	if x != nil {
+		return x.TermNumber
+	}
+	return 0
+}
+
+func (x *Billing) GetPaymentDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaymentDueDate
+	}
+	return nil
+}
+
+func (x *Billing) GetRepayment() *Money {
+	if x != nil {
+		return x.Repayment
+	}
+	return nil
+}
+
+func (x *Billing) GetIsPaid() bool {
+	if x != nil {
+		return x.IsPaid
+	}
+	return false
+}
+
+func (x *Billing) GetPaidAmount() *Money {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return nil
+}
+
+func (x *Billing) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *Billing) GetInterest() *Money {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *Billing) GetFee() *Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Billing) GetPenalty() *Money {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *Billing) GetPaidPrincipal() *Money {
+	if x != nil {
+		return x.PaidPrincipal
+	}
+	return nil
+}
+
+func (x *Billing) GetPaidInterest() *Money {
+	if x != nil {
+		return x.PaidInterest
+	}
+	return nil
+}
+
+func (x *Billing) GetPaidFee() *Money {
+	if x != nil {
+		return x.PaidFee
+	}
+	return nil
+}
+
+func (x *Billing) GetPaidPenalty() *Money {
+	if x != nil {
+		return x.PaidPenalty
+	}
+	return nil
+}
+
+func (x *Billing) GetDeferredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeferredAt
+	}
+	return nil
+}
+
+func (x *Billing) GetRebate() *Money {
+	if x != nil {
+		return x.Rebate
+	}
+	return nil
+}
+
+type GetOutstandingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetOutstandingRequest) Reset() {
+	*x = GetOutstandingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutstandingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingRequest) ProtoMessage() {}
+
+func (x *GetOutstandingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingRequest.ProtoReflect.Descriptor instead.
+func (*GetOutstandingRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetOutstandingRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetOutstandingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutstandingBalance int64  `protobuf:"varint,1,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"`
+	Decimal            int32  `protobuf:"varint,2,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	Currency           string `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *GetOutstandingResponse) Reset() {
+	*x = GetOutstandingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutstandingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutstandingResponse) ProtoMessage() {}
+
+func (x *GetOutstandingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutstandingResponse.ProtoReflect.Descriptor instead.
+func (*GetOutstandingResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetOutstandingResponse) GetOutstandingBalance() int64 {
+	if x != nil {
+		return x.OutstandingBalance
+	}
+	return 0
+}
+
+func (x *GetOutstandingResponse) GetDecimal() int32 {
+	if x != nil {
+		return x.Decimal
+	}
+	return 0
+}
+
+func (x *GetOutstandingResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type IsDelinquentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *IsDelinquentRequest) Reset() {
+	*x = IsDelinquentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDelinquentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDelinquentRequest) ProtoMessage() {}
+
+func (x *IsDelinquentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDelinquentRequest.ProtoReflect.Descriptor instead.
+func (*IsDelinquentRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IsDelinquentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type IsDelinquentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDelinquent bool `protobuf:"varint,1,opt,name=is_delinquent,json=isDelinquent,proto3" json:"is_delinquent,omitempty"`
+}
+
+func (x *IsDelinquentResponse) Reset() {
+	*x = IsDelinquentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsDelinquentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsDelinquentResponse) ProtoMessage() {}
+
+func (x *IsDelinquentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsDelinquentResponse.ProtoReflect.Descriptor instead.
+func (*IsDelinquentResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IsDelinquentResponse) GetIsDelinquent() bool {
+	if x != nil {
+		return x.IsDelinquent
+	}
+	return false
+}
+
+type DelinquencyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind           DelinquencyEventKind   `protobuf:"varint,1,opt,name=kind,proto3,enum=loanbilling.v1.DelinquencyEventKind" json:"kind,omitempty"`
+	At             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	MissedBillings int32                  `protobuf:"varint,3,opt,name=missed_billings,json=missedBillings,proto3" json:"missed_billings,omitempty"` // after the transition
+}
+
+func (x *DelinquencyEvent) Reset() {
+	*x = DelinquencyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelinquencyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelinquencyEvent) ProtoMessage() {}
+
+func (x *DelinquencyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelinquencyEvent.ProtoReflect.Descriptor instead.
+func (*DelinquencyEvent) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DelinquencyEvent) GetKind() DelinquencyEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DelinquencyEventKind_DELINQUENCY_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *DelinquencyEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *DelinquencyEvent) GetMissedBillings() int32 {
+	if x != nil {
+		return x.MissedBillings
+	}
+	return 0
+}
+
+type GetDelinquencyHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetDelinquencyHistoryRequest) Reset() {
+	*x = GetDelinquencyHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDelinquencyHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelinquencyHistoryRequest) ProtoMessage() {}
+
+func (x *GetDelinquencyHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelinquencyHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDelinquencyHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDelinquencyHistoryRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetDelinquencyHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DelinquencyEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // oldest first
+}
+
+func (x *GetDelinquencyHistoryResponse) Reset() {
+	*x = GetDelinquencyHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDelinquencyHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDelinquencyHistoryResponse) ProtoMessage() {}
+
+func (x *GetDelinquencyHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDelinquencyHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDelinquencyHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetDelinquencyHistoryResponse) GetEvents() []*DelinquencyEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type MakePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId   string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount   int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimal  int32                  `protobuf:"varint,3,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	Currency string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	When     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"`
+	// idempotency key of the client (e.g. the bank transfer reference), a retry with the same reference returns the
+	// payment recorded the first time
+	Reference string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *MakePaymentRequest) Reset() {
+	*x = MakePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakePaymentRequest) ProtoMessage() {}
+
+func (x *MakePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakePaymentRequest.ProtoReflect.Descriptor instead.
+func (*MakePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MakePaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MakePaymentRequest) GetDecimal() int32 {
+	if x != nil {
+		return x.Decimal
+	}
+	return 0
+}
+
+func (x *MakePaymentRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MakePaymentRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+func (x *MakePaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type MakePaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *MakePaymentResponse) Reset() {
+	*x = MakePaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakePaymentResponse) ProtoMessage() {}
+
+func (x *MakePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakePaymentResponse.ProtoReflect.Descriptor instead.
+func (*MakePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MakePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type ReversePaymentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId    string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	PaymentId string                 `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Reason    string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	When      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=when,proto3" json:"when,omitempty"` // default to now
+}
+
+func (x *ReversePaymentRequest) Reset() {
+	*x = ReversePaymentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReversePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReversePaymentRequest) ProtoMessage() {}
+
+func (x *ReversePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReversePaymentRequest.ProtoReflect.Descriptor instead.
+func (*ReversePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReversePaymentRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *ReversePaymentRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *ReversePaymentRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReversePaymentRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type ReversePaymentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *ReversePaymentResponse) Reset() {
+	*x = ReversePaymentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReversePaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReversePaymentResponse) ProtoMessage() {}
+
+func (x *ReversePaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReversePaymentResponse.ProtoReflect.Descriptor instead.
+func (*ReversePaymentResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReversePaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type CreateLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Principal             *Money `protobuf:"bytes,1,opt,name=principal,proto3" json:"principal,omitempty"`
+	AnnualInterestRateBps int32  `protobuf:"varint,2,opt,name=annual_interest_rate_bps,json=annualInterestRateBps,proto3" json:"annual_interest_rate_bps,omitempty"` // basis point (1 basis point = 0.01%), over rate_basis
+	// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+	LoanTermWeeks    int32                  `protobuf:"varint,3,opt,name=loan_term_weeks,json=loanTermWeeks,proto3" json:"loan_term_weeks,omitempty"` // used as loan_term of a weekly loan when loan_term is empty
+	AllocationPolicy AllocationPolicy       `protobuf:"varint,4,opt,name=allocation_policy,json=allocationPolicy,proto3,enum=loanbilling.v1.AllocationPolicy" json:"allocation_policy,omitempty"`
+	Frequency        Frequency              `protobuf:"varint,5,opt,name=frequency,proto3,enum=loanbilling.v1.Frequency" json:"frequency,omitempty"`
+	LoanTerm         int32                  `protobuf:"varint,6,opt,name=loan_term,json=loanTerm,proto3" json:"loan_term,omitempty"` // number of installments
+	InterestMethod   InterestMethod         `protobuf:"varint,7,opt,name=interest_method,json=interestMethod,proto3,enum=loanbilling.v1.InterestMethod" json:"interest_method,omitempty"`
+	RateBasis        RateBasis              `protobuf:"varint,8,opt,name=rate_basis,json=rateBasis,proto3,enum=loanbilling.v1.RateBasis" json:"rate_basis,omitempty"`
+	DayCount         DayCount               `protobuf:"varint,9,opt,name=day_count,json=dayCount,proto3,enum=loanbilling.v1.DayCount" json:"day_count,omitempty"`
+	Product          string                 `protobuf:"bytes,10,opt,name=product,proto3" json:"product,omitempty"`                                 // picks the delinquency rule overridden for the product
+	StartDate        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`            // when the loan was disbursed, default to now
+	FirstDueDate     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=first_due_date,json=firstDueDate,proto3" json:"first_due_date,omitempty"` // default to one installment after the start date
+}
+
+func (x *CreateLoanRequest) Reset() {
+	*x = CreateLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanRequest) ProtoMessage() {}
+
+func (x *CreateLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanRequest.ProtoReflect.Descriptor instead.
+func (*CreateLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateLoanRequest) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *CreateLoanRequest) GetAnnualInterestRateBps() int32 {
+	if x != nil {
+		return x.AnnualInterestRateBps
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in loanbilling/v1/loanbilling.proto.
+func (x *CreateLoanRequest) GetLoanTermWeeks() int32 {
+	if x != nil {
+		return x.LoanTermWeeks
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetAllocationPolicy() AllocationPolicy {
+	if x != nil {
+		return x.AllocationPolicy
+	}
+	return AllocationPolicy_ALLOCATION_POLICY_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetFrequency() Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetLoanTerm() int32 {
+	if x != nil {
+		return x.LoanTerm
+	}
+	return 0
+}
+
+func (x *CreateLoanRequest) GetInterestMethod() InterestMethod {
+	if x != nil {
+		return x.InterestMethod
+	}
+	return InterestMethod_INTEREST_METHOD_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetRateBasis() RateBasis {
+	if x != nil {
+		return x.RateBasis
+	}
+	return RateBasis_RATE_BASIS_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetDayCount() DayCount {
+	if x != nil {
+		return x.DayCount
+	}
+	return DayCount_DAY_COUNT_UNSPECIFIED
+}
+
+func (x *CreateLoanRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *CreateLoanRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *CreateLoanRequest) GetFirstDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDueDate
+	}
+	return nil
+}
+
+type CreateLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+}
+
+func (x *CreateLoanResponse) Reset() {
+	*x = CreateLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanResponse) ProtoMessage() {}
+
+func (x *CreateLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanResponse.ProtoReflect.Descriptor instead.
+func (*CreateLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type GetLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetLoanRequest) Reset() {
+	*x = GetLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanRequest) ProtoMessage() {}
+
+func (x *GetLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan              *Loan              `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+	DelinquencyStatus *DelinquencyStatus `protobuf:"bytes,2,opt,name=delinquency_status,json=delinquencyStatus,proto3" json:"delinquency_status,omitempty"`
+	Payments          []*Payment         `protobuf:"bytes,3,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *GetLoanResponse) Reset() {
+	*x = GetLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanResponse) ProtoMessage() {}
+
+func (x *GetLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetDelinquencyStatus() *DelinquencyStatus {
+	if x != nil {
+		return x.DelinquencyStatus
+	}
+	return nil
+}
+
+func (x *GetLoanResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type GetBillingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetBillingScheduleRequest) Reset() {
+	*x = GetBillingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingScheduleRequest) ProtoMessage() {}
+
+func (x *GetBillingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetBillingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetBillingScheduleRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetBillingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Billings []*Billing `protobuf:"bytes,1,rep,name=billings,proto3" json:"billings,omitempty"`
+}
+
+func (x *GetBillingScheduleResponse) Reset() {
+	*x = GetBillingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBillingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBillingScheduleResponse) ProtoMessage() {}
+
+func (x *GetBillingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBillingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetBillingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBillingScheduleResponse) GetBillings() []*Billing {
+	if x != nil {
+		return x.Billings
+	}
+	return nil
+}
+
+type PayoffQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId             string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	QuoteDate          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=quote_date,json=quoteDate,proto3" json:"quote_date,omitempty"`
+	OutstandingBalance *Money                 `protobuf:"bytes,3,opt,name=outstanding_balance,json=outstandingBalance,proto3" json:"outstanding_balance,omitempty"`
+	Credit             *Money                 `protobuf:"bytes,4,opt,name=credit,proto3" json:"credit,omitempty"`
+	RemainingTerms     int32                  `protobuf:"varint,5,opt,name=remaining_terms,json=remainingTerms,proto3" json:"remaining_terms,omitempty"` // terms that haven't started at the quote date
+	RebateRule         RebateRule             `protobuf:"varint,6,opt,name=rebate_rule,json=rebateRule,proto3,enum=loanbilling.v1.RebateRule" json:"rebate_rule,omitempty"`
+	InterestRebate     *Money                 `protobuf:"bytes,7,opt,name=interest_rebate,json=interestRebate,proto3" json:"interest_rebate,omitempty"`
+	PayoffAmount       *Money                 `protobuf:"bytes,8,opt,name=payoff_amount,json=payoffAmount,proto3" json:"payoff_amount,omitempty"` // outstanding - credit - rebate
+}
+
+func (x *PayoffQuote) Reset() {
+	*x = PayoffQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoffQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffQuote) ProtoMessage() {}
+
+func (x *PayoffQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffQuote.ProtoReflect.Descriptor instead.
+func (*PayoffQuote) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PayoffQuote) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *PayoffQuote) GetQuoteDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QuoteDate
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetOutstandingBalance() *Money {
+	if x != nil {
+		return x.OutstandingBalance
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetCredit() *Money {
+	if x != nil {
+		return x.Credit
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetRemainingTerms() int32 {
+	if x != nil {
+		return x.RemainingTerms
+	}
+	return 0
+}
+
+func (x *PayoffQuote) GetRebateRule() RebateRule {
+	if x != nil {
+		return x.RebateRule
+	}
+	return RebateRule_REBATE_RULE_UNSPECIFIED
+}
+
+func (x *PayoffQuote) GetInterestRebate() *Money {
+	if x != nil {
+		return x.InterestRebate
+	}
+	return nil
+}
+
+func (x *PayoffQuote) GetPayoffAmount() *Money {
+	if x != nil {
+		return x.PayoffAmount
+	}
+	return nil
+}
+
+type GetPayoffQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // default to now
+}
+
+func (x *GetPayoffQuoteRequest) Reset() {
+	*x = GetPayoffQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoffQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoffQuoteRequest) ProtoMessage() {}
+
+func (x *GetPayoffQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoffQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetPayoffQuoteRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetPayoffQuoteRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetPayoffQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quote *PayoffQuote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (x *GetPayoffQuoteResponse) Reset() {
+	*x = GetPayoffQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPayoffQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoffQuoteResponse) ProtoMessage() {}
+
+func (x *GetPayoffQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoffQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetPayoffQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetPayoffQuoteResponse) GetQuote() *PayoffQuote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+type SettleLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Amount *Money                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	When   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=when,proto3" json:"when,omitempty"`
+}
+
+func (x *SettleLoanRequest) Reset() {
+	*x = SettleLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleLoanRequest) ProtoMessage() {}
+
+func (x *SettleLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleLoanRequest.ProtoReflect.Descriptor instead.
+func (*SettleLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SettleLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *SettleLoanRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *SettleLoanRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type SettleLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+}
+
+func (x *SettleLoanResponse) Reset() {
+	*x = SettleLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettleLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleLoanResponse) ProtoMessage() {}
+
+func (x *SettleLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleLoanResponse.ProtoReflect.Descriptor instead.
+func (*SettleLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SettleLoanResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type Aging struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId         string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	AsOf           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	DaysPastDue    int32                  `protobuf:"varint,3,opt,name=days_past_due,json=daysPastDue,proto3" json:"days_past_due,omitempty"` // since the due date of the oldest unpaid billing
+	Bucket         Bucket                 `protobuf:"varint,4,opt,name=bucket,proto3,enum=loanbilling.v1.Bucket" json:"bucket,omitempty"`
+	Kolektibilitas int32                  `protobuf:"varint,5,opt,name=kolektibilitas,proto3" json:"kolektibilitas,omitempty"` // OJK 1 (lancar) - 5 (macet)
+	OverdueAmount  *Money                 `protobuf:"bytes,6,opt,name=overdue_amount,json=overdueAmount,proto3" json:"overdue_amount,omitempty"`
+}
+
+func (x *Aging) Reset() {
+	*x = Aging{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aging) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aging) ProtoMessage() {}
+
+func (x *Aging) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aging.ProtoReflect.Descriptor instead.
+func (*Aging) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Aging) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *Aging) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *Aging) GetDaysPastDue() int32 {
+	if x != nil {
+		return x.DaysPastDue
+	}
+	return 0
+}
+
+func (x *Aging) GetBucket() Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return Bucket_BUCKET_UNSPECIFIED
+}
+
+func (x *Aging) GetKolektibilitas() int32 {
+	if x != nil {
+		return x.Kolektibilitas
+	}
+	return 0
+}
+
+func (x *Aging) GetOverdueAmount() *Money {
+	if x != nil {
+		return x.OverdueAmount
+	}
+	return nil
+}
+
+type GetAgingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"` // default to now
+}
+
+func (x *GetAgingRequest) Reset() {
+	*x = GetAgingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgingRequest) ProtoMessage() {}
+
+func (x *GetAgingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgingRequest.ProtoReflect.Descriptor instead.
+func (*GetAgingRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAgingRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *GetAgingRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type GetAgingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aging *Aging `protobuf:"bytes,1,opt,name=aging,proto3" json:"aging,omitempty"`
+}
+
+func (x *GetAgingResponse) Reset() {
+	*x = GetAgingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAgingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgingResponse) ProtoMessage() {}
+
+func (x *GetAgingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgingResponse.ProtoReflect.Descriptor instead.
+func (*GetAgingResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAgingResponse) GetAging() *Aging {
+	if x != nil {
+		return x.Aging
+	}
+	return nil
+}
+
+// a version of the repayment terms of a loan
+type LoanTerms struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version               int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	StartDate             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	SupersededAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=superseded_at,json=supersededAt,proto3" json:"superseded_at,omitempty"` // unset for the current version
+	Principal             *Money                 `protobuf:"bytes,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	AnnualInterestRateBps int32                  `protobuf:"varint,5,opt,name=annual_interest_rate_bps,json=annualInterestRateBps,proto3" json:"annual_interest_rate_bps,omitempty"`
+	Frequency             Frequency              `protobuf:"varint,6,opt,name=frequency,proto3,enum=loanbilling.v1.Frequency" json:"frequency,omitempty"`
+	FirstDueDate          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=first_due_date,json=firstDueDate,proto3" json:"first_due_date,omitempty"`
+	LoanTerm              int32                  `protobuf:"varint,8,opt,name=loan_term,json=loanTerm,proto3" json:"loan_term,omitempty"`
+	InterestMethod        InterestMethod         `protobuf:"varint,9,opt,name=interest_method,json=interestMethod,proto3,enum=loanbilling.v1.InterestMethod" json:"interest_method,omitempty"`
+	RateBasis             RateBasis              `protobuf:"varint,10,opt,name=rate_basis,json=rateBasis,proto3,enum=loanbilling.v1.RateBasis" json:"rate_basis,omitempty"`
+	DayCount              DayCount               `protobuf:"varint,11,opt,name=day_count,json=dayCount,proto3,enum=loanbilling.v1.DayCount" json:"day_count,omitempty"`
+	TotalInterest         *Money                 `protobuf:"bytes,12,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	InstallmentAmount     *Money                 `protobuf:"bytes,13,opt,name=installment_amount,json=installmentAmount,proto3" json:"installment_amount,omitempty"` // of the first term
+}
+
+func (x *LoanTerms) Reset() {
+	*x = LoanTerms{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoanTerms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanTerms) ProtoMessage() {}
+
+func (x *LoanTerms) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanTerms.ProtoReflect.Descriptor instead.
+func (*LoanTerms) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LoanTerms) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *LoanTerms) GetSupersededAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SupersededAt
+	}
+	return nil
+}
+
+func (x *LoanTerms) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *LoanTerms) GetAnnualInterestRateBps() int32 {
+	if x != nil {
+		return x.AnnualInterestRateBps
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetFrequency() Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *LoanTerms) GetFirstDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDueDate
+	}
+	return nil
+}
+
+func (x *LoanTerms) GetLoanTerm() int32 {
+	if x != nil {
+		return x.LoanTerm
+	}
+	return 0
+}
+
+func (x *LoanTerms) GetInterestMethod() InterestMethod {
+	if x != nil {
+		return x.InterestMethod
+	}
+	return InterestMethod_INTEREST_METHOD_UNSPECIFIED
+}
+
+func (x *LoanTerms) GetRateBasis() RateBasis {
+	if x != nil {
+		return x.RateBasis
+	}
+	return RateBasis_RATE_BASIS_UNSPECIFIED
+}
+
+func (x *LoanTerms) GetDayCount() DayCount {
+	if x != nil {
+		return x.DayCount
+	}
+	return DayCount_DAY_COUNT_UNSPECIFIED
+}
+
+func (x *LoanTerms) GetTotalInterest() *Money {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return nil
+}
+
+func (x *LoanTerms) GetInstallmentAmount() *Money {
+	if x != nil {
+		return x.InstallmentAmount
+	}
+	return nil
+}
+
+type RestructureLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId                string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	LoanTerm              int32                  `protobuf:"varint,2,opt,name=loan_term,json=loanTerm,proto3" json:"loan_term,omitempty"`                                            // number of installments of the new schedule
+	AnnualInterestRateBps int32                  `protobuf:"varint,3,opt,name=annual_interest_rate_bps,json=annualInterestRateBps,proto3" json:"annual_interest_rate_bps,omitempty"` // over the rate basis of the loan
+	Frequency             Frequency              `protobuf:"varint,4,opt,name=frequency,proto3,enum=loanbilling.v1.Frequency" json:"frequency,omitempty"`                            // default to the frequency of the loan
+	FirstDueDate          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_due_date,json=firstDueDate,proto3" json:"first_due_date,omitempty"`                               // default to one installment after when
+	// add the arrears to the principal of the new schedule, otherwise they are due with its first installment
+	CapitalizeArrears bool                   `protobuf:"varint,6,opt,name=capitalize_arrears,json=capitalizeArrears,proto3" json:"capitalize_arrears,omitempty"`
+	When              *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=when,proto3" json:"when,omitempty"` // default to now
+}
+
+func (x *RestructureLoanRequest) Reset() {
+	*x = RestructureLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestructureLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanRequest) ProtoMessage() {}
+
+func (x *RestructureLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanRequest.ProtoReflect.Descriptor instead.
+func (*RestructureLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RestructureLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *RestructureLoanRequest) GetLoanTerm() int32 {
+	if x != nil {
+		return x.LoanTerm
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetAnnualInterestRateBps() int32 {
+	if x != nil {
+		return x.AnnualInterestRateBps
+	}
+	return 0
+}
+
+func (x *RestructureLoanRequest) GetFrequency() Frequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return Frequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *RestructureLoanRequest) GetFirstDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstDueDate
+	}
+	return nil
+}
+
+func (x *RestructureLoanRequest) GetCapitalizeArrears() bool {
+	if x != nil {
+		return x.CapitalizeArrears
+	}
+	return false
+}
+
+func (x *RestructureLoanRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type RestructureLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loan *Loan `protobuf:"bytes,1,opt,name=loan,proto3" json:"loan,omitempty"`
+}
+
+func (x *RestructureLoanResponse) Reset() {
+	*x = RestructureLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestructureLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestructureLoanResponse) ProtoMessage() {}
+
+func (x *RestructureLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestructureLoanResponse.ProtoReflect.Descriptor instead.
+func (*RestructureLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestructureLoanResponse) GetLoan() *Loan {
+	if x != nil {
+		return x.Loan
+	}
+	return nil
+}
+
+type GetLoanTermsHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetLoanTermsHistoryRequest) Reset() {
+	*x = GetLoanTermsHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanTermsHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanTermsHistoryRequest) ProtoMessage() {}
+
+func (x *GetLoanTermsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanTermsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetLoanTermsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetLoanTermsHistoryRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetLoanTermsHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms []*LoanTerms `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"` // oldest first, the current one last
+}
+
+func (x *GetLoanTermsHistoryResponse) Reset() {
+	*x = GetLoanTermsHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoanTermsHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanTermsHistoryResponse) ProtoMessage() {}
+
+func (x *GetLoanTermsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanTermsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetLoanTermsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetLoanTermsHistoryResponse) GetTerms() []*LoanTerms {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type DeferralEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	At             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	FromTerm       int32                  `protobuf:"varint,2,opt,name=from_term,json=fromTerm,proto3" json:"from_term,omitempty"` // the first deferred installment
+	Periods        int32                  `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	Method         DeferralMethod         `protobuf:"varint,4,opt,name=method,proto3,enum=loanbilling.v1.DeferralMethod" json:"method,omitempty"`
+	DeferredAmount *Money                 `protobuf:"bytes,5,opt,name=deferred_amount,json=deferredAmount,proto3" json:"deferred_amount,omitempty"` // what was left to pay of the deferred installments
+	Reason         string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DeferralEvent) Reset() {
+	*x = DeferralEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferralEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferralEvent) ProtoMessage() {}
+
+func (x *DeferralEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferralEvent.ProtoReflect.Descriptor instead.
+func (*DeferralEvent) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DeferralEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *DeferralEvent) GetFromTerm() int32 {
+	if x != nil {
+		return x.FromTerm
+	}
+	return 0
+}
+
+func (x *DeferralEvent) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *DeferralEvent) GetMethod() DeferralMethod {
+	if x != nil {
+		return x.Method
+	}
+	return DeferralMethod_DEFERRAL_METHOD_UNSPECIFIED
+}
+
+func (x *DeferralEvent) GetDeferredAmount() *Money {
+	if x != nil {
+		return x.DeferredAmount
+	}
+	return nil
+}
+
+func (x *DeferralEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeferInstallmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId  string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Periods int32                  `protobuf:"varint,2,opt,name=periods,proto3" json:"periods,omitempty"` // number of installments deferred
+	Method  DeferralMethod         `protobuf:"varint,3,opt,name=method,proto3,enum=loanbilling.v1.DeferralMethod" json:"method,omitempty"`
+	Reason  string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	When    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=when,proto3" json:"when,omitempty"` // default to now
+}
+
+func (x *DeferInstallmentsRequest) Reset() {
+	*x = DeferInstallmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferInstallmentsRequest) ProtoMessage() {}
+
+func (x *DeferInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*DeferInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeferInstallmentsRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *DeferInstallmentsRequest) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *DeferInstallmentsRequest) GetMethod() DeferralMethod {
+	if x != nil {
+		return x.Method
+	}
+	return DeferralMethod_DEFERRAL_METHOD_UNSPECIFIED
+}
+
+func (x *DeferInstallmentsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeferInstallmentsRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type DeferInstallmentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deferral *DeferralEvent `protobuf:"bytes,1,opt,name=deferral,proto3" json:"deferral,omitempty"`
+}
+
+func (x *DeferInstallmentsResponse) Reset() {
+	*x = DeferInstallmentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeferInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeferInstallmentsResponse) ProtoMessage() {}
+
+func (x *DeferInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeferInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*DeferInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeferInstallmentsResponse) GetDeferral() *DeferralEvent {
+	if x != nil {
+		return x.Deferral
+	}
+	return nil
+}
+
+type GetDeferralHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetDeferralHistoryRequest) Reset() {
+	*x = GetDeferralHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeferralHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeferralHistoryRequest) ProtoMessage() {}
+
+func (x *GetDeferralHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeferralHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDeferralHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetDeferralHistoryRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetDeferralHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*DeferralEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // oldest first
+}
+
+func (x *GetDeferralHistoryResponse) Reset() {
+	*x = GetDeferralHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeferralHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeferralHistoryResponse) ProtoMessage() {}
+
+func (x *GetDeferralHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeferralHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDeferralHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetDeferralHistoryResponse) GetEvents() []*DeferralEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type WriteOff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	At *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=at,proto3" json:"at,omitempty"`
+	// what was left to pay, written off
+	Principal *Money `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	Interest  *Money `protobuf:"bytes,3,opt,name=interest,proto3" json:"interest,omitempty"`
+	Fee       *Money `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Penalty   *Money `protobuf:"bytes,5,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Reason    string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *WriteOff) Reset() {
+	*x = WriteOff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOff) ProtoMessage() {}
+
+func (x *WriteOff) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOff.ProtoReflect.Descriptor instead.
+func (*WriteOff) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WriteOff) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *WriteOff) GetPrincipal() *Money {
+	if x != nil {
+		return x.Principal
+	}
+	return nil
+}
+
+func (x *WriteOff) GetInterest() *Money {
+	if x != nil {
+		return x.Interest
+	}
+	return nil
+}
+
+func (x *WriteOff) GetFee() *Money {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *WriteOff) GetPenalty() *Money {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *WriteOff) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WriteOffLoanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string                 `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+	Reason string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	When   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=when,proto3" json:"when,omitempty"` // default to now
+}
+
+func (x *WriteOffLoanRequest) Reset() {
+	*x = WriteOffLoanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOffLoanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanRequest) ProtoMessage() {}
+
+func (x *WriteOffLoanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanRequest.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WriteOffLoanRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+func (x *WriteOffLoanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *WriteOffLoanRequest) GetWhen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.When
+	}
+	return nil
+}
+
+type WriteOffLoanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WriteOff *WriteOff `protobuf:"bytes,1,opt,name=write_off,json=writeOff,proto3" json:"write_off,omitempty"`
+}
+
+func (x *WriteOffLoanResponse) Reset() {
+	*x = WriteOffLoanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteOffLoanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteOffLoanResponse) ProtoMessage() {}
+
+func (x *WriteOffLoanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteOffLoanResponse.ProtoReflect.Descriptor instead.
+func (*WriteOffLoanResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WriteOffLoanResponse) GetWriteOff() *WriteOff {
+	if x != nil {
+		return x.WriteOff
+	}
+	return nil
+}
+
+type GetWriteOffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoanId string `protobuf:"bytes,1,opt,name=loan_id,json=loanId,proto3" json:"loan_id,omitempty"`
+}
+
+func (x *GetWriteOffRequest) Reset() {
+	*x = GetWriteOffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWriteOffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWriteOffRequest) ProtoMessage() {}
+
+func (x *GetWriteOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWriteOffRequest.ProtoReflect.Descriptor instead.
+func (*GetWriteOffRequest) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetWriteOffRequest) GetLoanId() string {
+	if x != nil {
+		return x.LoanId
+	}
+	return ""
+}
+
+type GetWriteOffResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WriteOff   *WriteOff  `protobuf:"bytes,1,opt,name=write_off,json=writeOff,proto3" json:"write_off,omitempty"`
+	Recovered  *Money     `protobuf:"bytes,2,opt,name=recovered,proto3" json:"recovered,omitempty"`   // by the recovery payments not reversed
+	Recoveries []*Payment `protobuf:"bytes,3,rep,name=recoveries,proto3" json:"recoveries,omitempty"` // oldest first
+}
+
+func (x *GetWriteOffResponse) Reset() {
+	*x = GetWriteOffResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWriteOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWriteOffResponse) ProtoMessage() {}
+
+func (x *GetWriteOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_loanbilling_v1_loanbilling_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWriteOffResponse.ProtoReflect.Descriptor instead.
+func (*GetWriteOffResponse) Descriptor() ([]byte, []int) {
+	return file_loanbilling_v1_loanbilling_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetWriteOffResponse) GetWriteOff() *WriteOff {
+	if x != nil {
+		return x.WriteOff
+	}
+	return nil
+}
+
+func (x *GetWriteOffResponse) GetRecovered() *Money {
+	if x != nil {
+		return x.Recovered
+	}
+	return nil
+}
+
+func (x *GetWriteOffResponse) GetRecoveries() []*Payment {
+	if x != nil {
+		return x.Recoveries
+	}
+	return nil
+}
+
+var File_loanbilling_v1_loanbilling_proto protoreflect.FileDescriptor
+
+var file_loanbilling_v1_loanbilling_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc7, 0x0a, 0x0a, 0x04, 0x4c,
+	0x6f, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x75,
+	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x6e, 0x6e, 0x75,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70,
+	0x73, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x13, 0x6f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12,
+	0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x57, 0x65, 0x65, 0x6b,
+	0x73, 0x12, 0x40, 0x0a, 0x0e, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x44, 0x0a, 0x12, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x48, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x73,
+	0x69, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x42, 0x61,
+	0x73, 0x69, 0x73, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x35,
+	0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x61, 0x79,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12,
+	0x4a, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x6e,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69,
+	0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x66,
+	0x66, 0x5f, 0x61, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x4f,
+	0x66, 0x66, 0x41, 0x74, 0x22, 0x6a, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x65,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x61, 0x63, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x22, 0x93, 0x03, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69,
+	0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x65, 0x65, 0x12, 0x22, 0x0a,
+	0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61, 0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75,
+	0x65, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6b, 0x6f, 0x6c, 0x65, 0x6b, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6b, 0x6f, 0x6c, 0x65, 0x6b,
+	0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x67, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x61, 0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x12,
+	0x35, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x98, 0x05, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x3a, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2f, 0x0a, 0x07,
+	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x62, 0x61, 0x74,
+	0x65, 0x22, 0x8a, 0x06, 0x0a, 0x07, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x65, 0x72, 0x6d, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44,
+	0x0a, 0x10, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09,
+	0x72, 0x65, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61,
+	0x69, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a,
+	0x70, 0x61, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72,
+	0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12,
+	0x31, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70,
+	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0e,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x70, 0x61, 0x69,
+	0x64, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x70, 0x61, 0x69, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x07, 0x70, 0x61, 0x69, 0x64, 0x46, 0x65, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x64,
+	0x5f, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0b, 0x70, 0x61, 0x69, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x22, 0x30,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x7f, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x22, 0x2e, 0x0a, 0x13, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x22, 0x3b, 0x0a, 0x14, 0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x22, 0xa1,
+	0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2a, 0x0a,
+	0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x37, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x6b, 0x65, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x22, 0x48, 0x0a, 0x13, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x97, 0x01, 0x0a,
+	0x15, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x22, 0x4b, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x22, 0xa3, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x37,
+	0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73,
+	0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74,
+	0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x0f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x74, 0x65, 0x72, 0x6d, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x57, 0x65,
+	0x65, 0x6b, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64,
+	0x61, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x61, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0x3e, 0x0a, 0x12, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f,
+	0x61, 0x6e, 0x12, 0x50, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x34, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x22,
+	0x51, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x08, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0xba, 0x03, 0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x71, 0x75, 0x6f,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x13, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x12, 0x6f, 0x75, 0x74, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x62, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x65, 0x62,
+	0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x5c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x22, 0x4b, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x8b, 0x02, 0x0a, 0x05, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x70, 0x61,
+	0x73, 0x74, 0x5f, 0x64, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x64, 0x61,
+	0x79, 0x73, 0x50, 0x61, 0x73, 0x74, 0x44, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6b, 0x6f, 0x6c,
+	0x65, 0x6b, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x61, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x6b, 0x6f, 0x6c, 0x65, 0x6b, 0x74, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x61,
+	0x73, 0x12, 0x3c, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x02, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x22, 0x3f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x67,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x22, 0xe5, 0x05, 0x0a, 0x09, 0x4c, 0x6f, 0x61,
+	0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x73,
+	0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c,
+	0x73, 0x75, 0x70, 0x65, 0x72, 0x73, 0x65, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x09,
+	0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61,
+	0x6c, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f,
+	0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x73, 0x69, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x61, 0x79, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x08, 0x64, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0e,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x12, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x11, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xe1, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c,
+	0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x61, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65, 0x72,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72,
+	0x6d, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x15, 0x61, 0x6e, 0x6e, 0x75, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x40, 0x0a, 0x0e, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x64, 0x75, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x66, 0x69, 0x72, 0x73, 0x74, 0x44, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x5f, 0x61, 0x72, 0x72, 0x65, 0x61, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x41, 0x72, 0x72,
+	0x65, 0x61, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x22, 0x43, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x04, 0x6c, 0x6f, 0x61, 0x6e, 0x22, 0x35, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64,
+	0x22, 0x4e, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d, 0x73,
+	0x22, 0x82, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3e, 0x0a,
+	0x0f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x64,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x77, 0x68, 0x65, 0x6e, 0x22, 0x56, 0x0a, 0x19, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x22, 0x34, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61,
+	0x6e, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x61, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x90, 0x02, 0x0a, 0x08, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x4f, 0x66, 0x66, 0x12, 0x2a, 0x0a, 0x02, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x61,
+	0x74, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65,
+	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x13, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x77,
+	0x68, 0x65, 0x6e, 0x22, 0x4d, 0x0a, 0x14, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c,
+	0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f,
+	0x66, 0x66, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x6e, 0x49,
+	0x64, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66,
+	0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x2a, 0xa3,
+	0x01, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x41, 0x50, 0x50, 0x4c,
+	0x59, 0x5f, 0x54, 0x4f, 0x5f, 0x46, 0x55, 0x54, 0x55, 0x52, 0x45, 0x10, 0x01, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49,
+	0x54, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x5f, 0x44,
+	0x55, 0x45, 0x10, 0x03, 0x2a, 0x6b, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x79, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59,
+	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f,
+	0x42, 0x49, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c, 0x59, 0x10,
+	0x03, 0x2a, 0x8f, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53,
+	0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x10, 0x01, 0x12,
+	0x25, 0x0a, 0x21, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x45,
+	0x53, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x4e, 0x4e, 0x55, 0x49, 0x54,
+	0x59, 0x10, 0x03, 0x2a, 0x70, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x41, 0x4e, 0x4e, 0x55, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49,
+	0x53, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x4e, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x16, 0x0a,
+	0x12, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x41, 0x53, 0x49, 0x53, 0x5f, 0x4d, 0x4f, 0x4e, 0x54,
+	0x48, 0x4c, 0x59, 0x10, 0x03, 0x2a, 0x7d, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x12, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x55, 0x43, 0x4b, 0x45,
+	0x54, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42,
+	0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x31, 0x5f, 0x33, 0x30, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x33, 0x31, 0x5f, 0x36, 0x30, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x36, 0x31, 0x5f, 0x39, 0x30, 0x10, 0x04,
+	0x12, 0x12, 0x0a, 0x0e, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x39, 0x30, 0x5f, 0x50, 0x4c,
+	0x55, 0x53, 0x10, 0x05, 0x2a, 0x87, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a,
+	0x22, 0x44, 0x45, 0x4c, 0x49, 0x4e, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x44, 0x45, 0x4c, 0x49, 0x4e, 0x51, 0x55,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x44, 0x45, 0x4c, 0x49, 0x4e, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c,
+	0x44, 0x45, 0x4c, 0x49, 0x4e, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x62,
+	0x0a, 0x0b, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50,
+	0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x50, 0x41,
+	0x59, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59,
+	0x10, 0x02, 0x2a, 0x53, 0x0a, 0x08, 0x44, 0x61, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x15, 0x44, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x59,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x5f, 0x33, 0x36, 0x35, 0x10, 0x01,
+	0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x33, 0x30,
+	0x45, 0x5f, 0x33, 0x36, 0x30, 0x10, 0x02, 0x2a, 0x6f, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x61, 0x6c, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x45, 0x46,
+	0x45, 0x52, 0x52, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x45,
+	0x46, 0x45, 0x52, 0x52, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x45, 0x58,
+	0x54, 0x45, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x4e, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16,
+	0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
+	0x53, 0x50, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x2a, 0x75, 0x0a, 0x0a, 0x52, 0x65, 0x62, 0x61,
+	0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x42, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x42, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55,
+	0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x42,
+	0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x5f, 0x52, 0x41, 0x54,
+	0x41, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x42, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55,
+	0x4c, 0x45, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x37, 0x38, 0x10, 0x03, 0x32,
+	0x92, 0x0d, 0x0a, 0x12, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x49, 0x73, 0x44,
+	0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x44, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x73, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c,
+	0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x2c, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x6c, 0x69, 0x6e, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58,
+	0x0a, 0x0b, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4c, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1e, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0x25, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6f, 0x66, 0x66, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x21, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x41, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c,
+	0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x67, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0f, 0x52, 0x65,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x26, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x54, 0x65, 0x72, 0x6d, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6a, 0x0a, 0x11, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x0c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x23, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x4c, 0x6f, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x12, 0x22, 0x2e, 0x6c, 0x6f, 0x61, 0x6e,
+	0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x42, 0xc7, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x6c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x4c, 0x6f, 0x61,
+	0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x68, 0x72,
+	0x75, 0x6e, 0x6e, 0x75, 0x72, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x2d, 0x62, 0x69, 0x6c, 0x6c, 0x69,
+	0x6e, 0x67, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6c, 0x6f, 0x61, 0x6e, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x6c, 0x6f, 0x61, 0x6e, 0x62, 0x69,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4c, 0x58, 0x58, 0xaa, 0x02, 0x0e,
+	0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0e, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1a, 0x4c, 0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4c,
+	0x6f, 0x61, 0x6e, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_loanbilling_v1_loanbilling_proto_rawDescOnce sync.Once
+	file_loanbilling_v1_loanbilling_proto_rawDescData = file_loanbilling_v1_loanbilling_proto_rawDesc
+)
+
+func file_loanbilling_v1_loanbilling_proto_rawDescGZIP() []byte {
+	file_loanbilling_v1_loanbilling_proto_rawDescOnce.Do(func() {
+		file_loanbilling_v1_loanbilling_proto_rawDescData = protoimpl.X.CompressGZIP(file_loanbilling_v1_loanbilling_proto_rawDescData)
+	})
+	return file_loanbilling_v1_loanbilling_proto_rawDescData
+}
+
+var file_loanbilling_v1_loanbilling_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_loanbilling_v1_loanbilling_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_loanbilling_v1_loanbilling_proto_goTypes = []any{
+	(AllocationPolicy)(0),                 // 0: loanbilling.v1.AllocationPolicy
+	(Frequency)(0),                        // 1: loanbilling.v1.Frequency
+	(InterestMethod)(0),                   // 2: loanbilling.v1.InterestMethod
+	(RateBasis)(0),                        // 3: loanbilling.v1.RateBasis
+	(Bucket)(0),                           // 4: loanbilling.v1.Bucket
+	(DelinquencyEventKind)(0),             // 5: loanbilling.v1.DelinquencyEventKind
+	(PaymentKind)(0),                      // 6: loanbilling.v1.PaymentKind
+	(DayCount)(0),                         // 7: loanbilling.v1.DayCount
+	(DeferralMethod)(0),                   // 8: loanbilling.v1.DeferralMethod
+	(RebateRule)(0),                       // 9: loanbilling.v1.RebateRule
+	(*Money)(nil),                         // 10: loanbilling.v1.Money
+	(*Loan)(nil),                          // 11: loanbilling.v1.Loan
+	(*DelinquencyRule)(nil),               // 12: loanbilling.v1.DelinquencyRule
+	(*DelinquencyStatus)(nil),             // 13: loanbilling.v1.DelinquencyStatus
+	(*Payment)(nil),                       // 14: loanbilling.v1.Payment
+	(*Billing)(nil),                       // 15: loanbilling.v1.Billing
+	(*GetOutstandingRequest)(nil),         // 16: loanbilling.v1.GetOutstandingRequest
+	(*GetOutstandingResponse)(nil),        // 17: loanbilling.v1.GetOutstandingResponse
+	(*IsDelinquentRequest)(nil),           // 18: loanbilling.v1.IsDelinquentRequest
+	(*IsDelinquentResponse)(nil),          // 19: loanbilling.v1.IsDelinquentResponse
+	(*DelinquencyEvent)(nil),              // 20: loanbilling.v1.DelinquencyEvent
+	(*GetDelinquencyHistoryRequest)(nil),  // 21: loanbilling.v1.GetDelinquencyHistoryRequest
+	(*GetDelinquencyHistoryResponse)(nil), // 22: loanbilling.v1.GetDelinquencyHistoryResponse
+	(*MakePaymentRequest)(nil),            // 23: loanbilling.v1.MakePaymentRequest
+	(*MakePaymentResponse)(nil),           // 24: loanbilling.v1.MakePaymentResponse
+	(*ReversePaymentRequest)(nil),         // 25: loanbilling.v1.ReversePaymentRequest
+	(*ReversePaymentResponse)(nil),        // 26: loanbilling.v1.ReversePaymentResponse
+	(*CreateLoanRequest)(nil),             // 27: loanbilling.v1.CreateLoanRequest
+	(*CreateLoanResponse)(nil),            // 28: loanbilling.v1.CreateLoanResponse
+	(*GetLoanRequest)(nil),                // 29: loanbilling.v1.GetLoanRequest
+	(*GetLoanResponse)(nil),               // 30: loanbilling.v1.GetLoanResponse
+	(*GetBillingScheduleRequest)(nil),     // 31: loanbilling.v1.GetBillingScheduleRequest
+	(*GetBillingScheduleResponse)(nil),    // 32: loanbilling.v1.GetBillingScheduleResponse
+	(*PayoffQuote)(nil),                   // 33: loanbilling.v1.PayoffQuote
+	(*GetPayoffQuoteRequest)(nil),         // 34: loanbilling.v1.GetPayoffQuoteRequest
+	(*GetPayoffQuoteResponse)(nil),        // 35: loanbilling.v1.GetPayoffQuoteResponse
+	(*SettleLoanRequest)(nil),             // 36: loanbilling.v1.SettleLoanRequest
+	(*SettleLoanResponse)(nil),            // 37: loanbilling.v1.SettleLoanResponse
+	(*Aging)(nil),                         // 38: loanbilling.v1.Aging
+	(*GetAgingRequest)(nil),               // 39: loanbilling.v1.GetAgingRequest
+	(*GetAgingResponse)(nil),              // 40: loanbilling.v1.GetAgingResponse
+	(*LoanTerms)(nil),                     // 41: loanbilling.v1.LoanTerms
+	(*RestructureLoanRequest)(nil),        // 42: loanbilling.v1.RestructureLoanRequest
+	(*RestructureLoanResponse)(nil),       // 43: loanbilling.v1.RestructureLoanResponse
+	(*GetLoanTermsHistoryRequest)(nil),    // 44: loanbilling.v1.GetLoanTermsHistoryRequest
+	(*GetLoanTermsHistoryResponse)(nil),   // 45: loanbilling.v1.GetLoanTermsHistoryResponse
+	(*DeferralEvent)(nil),                 // 46: loanbilling.v1.DeferralEvent
+	(*DeferInstallmentsRequest)(nil),      // 47: loanbilling.v1.DeferInstallmentsRequest
+	(*DeferInstallmentsResponse)(nil),     // 48: loanbilling.v1.DeferInstallmentsResponse
+	(*GetDeferralHistoryRequest)(nil),     // 49: loanbilling.v1.GetDeferralHistoryRequest
+	(*GetDeferralHistoryResponse)(nil),    // 50: loanbilling.v1.GetDeferralHistoryResponse
+	(*WriteOff)(nil),                      // 51: loanbilling.v1.WriteOff
+	(*WriteOffLoanRequest)(nil),           // 52: loanbilling.v1.WriteOffLoanRequest
+	(*WriteOffLoanResponse)(nil),          // 53: loanbilling.v1.WriteOffLoanResponse
+	(*GetWriteOffRequest)(nil),            // 54: loanbilling.v1.GetWriteOffRequest
+	(*GetWriteOffResponse)(nil),           // 55: loanbilling.v1.GetWriteOffResponse
+	(*timestamppb.Timestamp)(nil),         // 56: google.protobuf.Timestamp
+}
+var file_loanbilling_v1_loanbilling_proto_depIdxs = []int32{
+	10,  // 0: loanbilling.v1.Loan.principal:type_name -> loanbilling.v1.Money
+	56,  // 1: loanbilling.v1.Loan.start_date:type_name -> google.protobuf.Timestamp
+	10,  // 2: loanbilling.v1.Loan.total_interest:type_name -> loanbilling.v1.Money
+	10,  // 3: loanbilling.v1.Loan.outstanding_balance:type_name -> loanbilling.v1.Money
+	10,  // 4: loanbilling.v1.Loan.weekly_payment:type_name -> loanbilling.v1.Money
+	10,  // 5: loanbilling.v1.Loan.weekly_interest:type_name -> loanbilling.v1.Money
+	0,   // 6: loanbilling.v1.Loan.allocation_policy:type_name -> loanbilling.v1.AllocationPolicy
+	10,  // 7: loanbilling.v1.Loan.credit:type_name -> loanbilling.v1.Money
+	1,   // 8: loanbilling.v1.Loan.frequency:type_name -> loanbilling.v1.Frequency
+	10,  // 9: loanbilling.v1.Loan.installment_amount:type_name -> loanbilling.v1.Money
+	10,  // 10: loanbilling.v1.Loan.installment_interest:type_name -> loanbilling.v1.Money
+	2,   // 11: loanbilling.v1.Loan.interest_method:type_name -> loanbilling.v1.InterestMethod
+	3,   // 12: loanbilling.v1.Loan.rate_basis:type_name -> loanbilling.v1.RateBasis
+	7,   // 13: loanbilling.v1.Loan.day_count:type_name -> loanbilling.v1.DayCount
+	12,  // 14: loanbilling.v1.Loan.delinquency_rule:type_name -> loanbilling.v1.DelinquencyRule
+	56,  // 15: loanbilling.v1.Loan.first_due_date:type_name -> google.protobuf.Timestamp
+	56,  // 16: loanbilling.v1.Loan.written_off_at:type_name -> google.protobuf.Timestamp
+	10,  // 17: loanbilling.v1.DelinquencyStatus.late_fee:type_name -> loanbilling.v1.Money
+	4,   // 18: loanbilling.v1.DelinquencyStatus.bucket:type_name -> loanbilling.v1.Bucket
+	56,  // 19: loanbilling.v1.DelinquencyStatus.aged_at:type_name -> google.protobuf.Timestamp
+	56,  // 20: loanbilling.v1.DelinquencyStatus.delinquent_at:type_name -> google.protobuf.Timestamp
+	56,  // 21: loanbilling.v1.DelinquencyStatus.cured_at:type_name -> google.protobuf.Timestamp
+	56,  // 22: loanbilling.v1.Payment.date:type_name -> google.protobuf.Timestamp
+	10,  // 23: loanbilling.v1.Payment.amount:type_name -> loanbilling.v1.Money
+	10,  // 24: loanbilling.v1.Payment.balance_before:type_name -> loanbilling.v1.Money
+	10,  // 25: loanbilling.v1.Payment.balance_after:type_name -> loanbilling.v1.Money
+	10,  // 26: loanbilling.v1.Payment.principal:type_name -> loanbilling.v1.Money
+	10,  // 27: loanbilling.v1.Payment.interest:type_name -> loanbilling.v1.Money
+	10,  // 28: loanbilling.v1.Payment.fee:type_name -> loanbilling.v1.Money
+	10,  // 29: loanbilling.v1.Payment.penalty:type_name -> loanbilling.v1.Money
+	56,  // 30: loanbilling.v1.Payment.reversed_at:type_name -> google.protobuf.Timestamp
+	6,   // 31: loanbilling.v1.Payment.kind:type_name -> loanbilling.v1.PaymentKind
+	10,  // 32: loanbilling.v1.Payment.rebate:type_name -> loanbilling.v1.Money
+	56,  // 33: loanbilling.v1.Billing.payment_due_date:type_name -> google.protobuf.Timestamp
+	10,  // 34: loanbilling.v1.Billing.repayment:type_name -> loanbilling.v1.Money
+	10,  // 35: loanbilling.v1.Billing.paid_amount:type_name -> loanbilling.v1.Money
+	10,  // 36: loanbilling.v1.Billing.principal:type_name -> loanbilling.v1.Money
+	10,  // 37: loanbilling.v1.Billing.interest:type_name -> loanbilling.v1.Money
+	10,  // 38: loanbilling.v1.Billing.fee:type_name -> loanbilling.v1.Money
+	10,  // 39: loanbilling.v1.Billing.penalty:type_name -> loanbilling.v1.Money
+	10,  // 40: loanbilling.v1.Billing.paid_principal:type_name -> loanbilling.v1.Money
+	10,  // 41: loanbilling.v1.Billing.paid_interest:type_name -> loanbilling.v1.Money
+	10,  // 42: loanbilling.v1.Billing.paid_fee:type_name -> loanbilling.v1.Money
+	10,  // 43: loanbilling.v1.Billing.paid_penalty:type_name -> loanbilling.v1.Money
+	56,  // 44: loanbilling.v1.Billing.deferred_at:type_name -> google.protobuf.Timestamp
+	10,  // 45: loanbilling.v1.Billing.rebate:type_name -> loanbilling.v1.Money
+	5,   // 46: loanbilling.v1.DelinquencyEvent.kind:type_name -> loanbilling.v1.DelinquencyEventKind
+	56,  // 47: loanbilling.v1.DelinquencyEvent.at:type_name -> google.protobuf.Timestamp
+	20,  // 48: loanbilling.v1.GetDelinquencyHistoryResponse.events:type_name -> loanbilling.v1.DelinquencyEvent
+	56,  // 49: loanbilling.v1.MakePaymentRequest.when:type_name -> google.protobuf.Timestamp
+	14,  // 50: loanbilling.v1.MakePaymentResponse.payment:type_name -> loanbilling.v1.Payment
+	56,  // 51: loanbilling.v1.ReversePaymentRequest.when:type_name -> google.protobuf.Timestamp
+	14,  // 52: loanbilling.v1.ReversePaymentResponse.payment:type_name -> loanbilling.v1.Payment
+	10,  // 53: loanbilling.v1.CreateLoanRequest.principal:type_name -> loanbilling.v1.Money
+	0,   // 54: loanbilling.v1.CreateLoanRequest.allocation_policy:type_name -> loanbilling.v1.AllocationPolicy
+	1,   // 55: loanbilling.v1.CreateLoanRequest.frequency:type_name -> loanbilling.v1.Frequency
+	2,   // 56: loanbilling.v1.CreateLoanRequest.interest_method:type_name -> loanbilling.v1.InterestMethod
+	3,   // 57: loanbilling.v1.CreateLoanRequest.rate_basis:type_name -> loanbilling.v1.RateBasis
+	7,   // 58: loanbilling.v1.CreateLoanRequest.day_count:type_name -> loanbilling.v1.DayCount
+	56,  // 59: loanbilling.v1.CreateLoanRequest.start_date:type_name -> google.protobuf.Timestamp
+	56,  // 60: loanbilling.v1.CreateLoanRequest.first_due_date:type_name -> google.protobuf.Timestamp
+	11,  // 61: loanbilling.v1.CreateLoanResponse.loan:type_name -> loanbilling.v1.Loan
+	11,  // 62: loanbilling.v1.GetLoanResponse.loan:type_name -> loanbilling.v1.Loan
+	13,  // 63: loanbilling.v1.GetLoanResponse.delinquency_status:type_name -> loanbilling.v1.DelinquencyStatus
+	14,  // 64: loanbilling.v1.GetLoanResponse.payments:type_name -> loanbilling.v1.Payment
+	15,  // 65: loanbilling.v1.GetBillingScheduleResponse.billings:type_name -> loanbilling.v1.Billing
+	56,  // 66: loanbilling.v1.PayoffQuote.quote_date:type_name -> google.protobuf.Timestamp
+	10,  // 67: loanbilling.v1.PayoffQuote.outstanding_balance:type_name -> loanbilling.v1.Money
+	10,  // 68: loanbilling.v1.PayoffQuote.credit:type_name -> loanbilling.v1.Money
+	9,   // 69: loanbilling.v1.PayoffQuote.rebate_rule:type_name -> loanbilling.v1.RebateRule
+	10,  // 70: loanbilling.v1.PayoffQuote.interest_rebate:type_name -> loanbilling.v1.Money
+	10,  // 71: loanbilling.v1.PayoffQuote.payoff_amount:type_name -> loanbilling.v1.Money
+	56,  // 72: loanbilling.v1.GetPayoffQuoteRequest.at:type_name -> google.protobuf.Timestamp
+	33,  // 73: loanbilling.v1.GetPayoffQuoteResponse.quote:type_name -> loanbilling.v1.PayoffQuote
+	10,  // 74: loanbilling.v1.SettleLoanRequest.amount:type_name -> loanbilling.v1.Money
+	56,  // 75: loanbilling.v1.SettleLoanRequest.when:type_name -> google.protobuf.Timestamp
+	14,  // 76: loanbilling.v1.SettleLoanResponse.payment:type_name -> loanbilling.v1.Payment
+	56,  // 77: loanbilling.v1.Aging.as_of:type_name -> google.protobuf.Timestamp
+	4,   // 78: loanbilling.v1.Aging.bucket:type_name -> loanbilling.v1.Bucket
+	10,  // 79: loanbilling.v1.Aging.overdue_amount:type_name -> loanbilling.v1.Money
+	56,  // 80: loanbilling.v1.GetAgingRequest.at:type_name -> google.protobuf.Timestamp
+	38,  // 81: loanbilling.v1.GetAgingResponse.aging:type_name -> loanbilling.v1.Aging
+	56,  // 82: loanbilling.v1.LoanTerms.start_date:type_name -> google.protobuf.Timestamp
+	56,  // 83: loanbilling.v1.LoanTerms.superseded_at:type_name -> google.protobuf.Timestamp
+	10,  // 84: loanbilling.v1.LoanTerms.principal:type_name -> loanbilling.v1.Money
+	1,   // 85: loanbilling.v1.LoanTerms.frequency:type_name -> loanbilling.v1.Frequency
+	56,  // 86: loanbilling.v1.LoanTerms.first_due_date:type_name -> google.protobuf.Timestamp
+	2,   // 87: loanbilling.v1.LoanTerms.interest_method:type_name -> loanbilling.v1.InterestMethod
+	3,   // 88: loanbilling.v1.LoanTerms.rate_basis:type_name -> loanbilling.v1.RateBasis
+	7,   // 89: loanbilling.v1.LoanTerms.day_count:type_name -> loanbilling.v1.DayCount
+	10,  // 90: loanbilling.v1.LoanTerms.total_interest:type_name -> loanbilling.v1.Money
+	10,  // 91: loanbilling.v1.LoanTerms.installment_amount:type_name -> loanbilling.v1.Money
+	1,   // 92: loanbilling.v1.RestructureLoanRequest.frequency:type_name -> loanbilling.v1.Frequency
+	56,  // 93: loanbilling.v1.RestructureLoanRequest.first_due_date:type_name -> google.protobuf.Timestamp
+	56,  // 94: loanbilling.v1.RestructureLoanRequest.when:type_name -> google.protobuf.Timestamp
+	11,  // 95: loanbilling.v1.RestructureLoanResponse.loan:type_name -> loanbilling.v1.Loan
+	41,  // 96: loanbilling.v1.GetLoanTermsHistoryResponse.terms:type_name -> loanbilling.v1.LoanTerms
+	56,  // 97: loanbilling.v1.DeferralEvent.at:type_name -> google.protobuf.Timestamp
+	8,   // 98: loanbilling.v1.DeferralEvent.method:type_name -> loanbilling.v1.DeferralMethod
+	10,  // 99: loanbilling.v1.DeferralEvent.deferred_amount:type_name -> loanbilling.v1.Money
+	8,   // 100: loanbilling.v1.DeferInstallmentsRequest.method:type_name -> loanbilling.v1.DeferralMethod
+	56,  // 101: loanbilling.v1.DeferInstallmentsRequest.when:type_name -> google.protobuf.Timestamp
+	46,  // 102: loanbilling.v1.DeferInstallmentsResponse.deferral:type_name -> loanbilling.v1.DeferralEvent
+	46,  // 103: loanbilling.v1.GetDeferralHistoryResponse.events:type_name -> loanbilling.v1.DeferralEvent
+	56,  // 104: loanbilling.v1.WriteOff.at:type_name -> google.protobuf.Timestamp
+	10,  // 105: loanbilling.v1.WriteOff.principal:type_name -> loanbilling.v1.Money
+	10,  // 106: loanbilling.v1.WriteOff.interest:type_name -> loanbilling.v1.Money
+	10,  // 107: loanbilling.v1.WriteOff.fee:type_name -> loanbilling.v1.Money
+	10,  // 108: loanbilling.v1.WriteOff.penalty:type_name -> loanbilling.v1.Money
+	56,  // 109: loanbilling.v1.WriteOffLoanRequest.when:type_name -> google.protobuf.Timestamp
+	51,  // 110: loanbilling.v1.WriteOffLoanResponse.write_off:type_name -> loanbilling.v1.WriteOff
+	51,  // 111: loanbilling.v1.GetWriteOffResponse.write_off:type_name -> loanbilling.v1.WriteOff
+	10,  // 112: loanbilling.v1.GetWriteOffResponse.recovered:type_name -> loanbilling.v1.Money
+	14,  // 113: loanbilling.v1.GetWriteOffResponse.recoveries:type_name -> loanbilling.v1.Payment
+	16,  // 114: loanbilling.v1.LoanBillingService.GetOutstanding:input_type -> loanbilling.v1.GetOutstandingRequest
+	18,  // 115: loanbilling.v1.LoanBillingService.IsDelinquent:input_type -> loanbilling.v1.IsDelinquentRequest
+	21,  // 116: loanbilling.v1.LoanBillingService.GetDelinquencyHistory:input_type -> loanbilling.v1.GetDelinquencyHistoryRequest
+	23,  // 117: loanbilling.v1.LoanBillingService.MakePayment:input_type -> loanbilling.v1.MakePaymentRequest
+	25,  // 118: loanbilling.v1.LoanBillingService.ReversePayment:input_type -> loanbilling.v1.ReversePaymentRequest
+	27,  // 119: loanbilling.v1.LoanBillingService.CreateLoan:input_type -> loanbilling.v1.CreateLoanRequest
+	29,  // 120: loanbilling.v1.LoanBillingService.GetLoan:input_type -> loanbilling.v1.GetLoanRequest
+	31,  // 121: loanbilling.v1.LoanBillingService.GetBillingSchedule:input_type -> loanbilling.v1.GetBillingScheduleRequest
+	34,  // 122: loanbilling.v1.LoanBillingService.GetPayoffQuote:input_type -> loanbilling.v1.GetPayoffQuoteRequest
+	36,  // 123: loanbilling.v1.LoanBillingService.SettleLoan:input_type -> loanbilling.v1.SettleLoanRequest
+	39,  // 124: loanbilling.v1.LoanBillingService.GetAging:input_type -> loanbilling.v1.GetAgingRequest
+	42,  // 125: loanbilling.v1.LoanBillingService.RestructureLoan:input_type -> loanbilling.v1.RestructureLoanRequest
+	44,  // 126: loanbilling.v1.LoanBillingService.GetLoanTermsHistory:input_type -> loanbilling.v1.GetLoanTermsHistoryRequest
+	47,  // 127: loanbilling.v1.LoanBillingService.DeferInstallments:input_type -> loanbilling.v1.DeferInstallmentsRequest
+	49,  // 128: loanbilling.v1.LoanBillingService.GetDeferralHistory:input_type -> loanbilling.v1.GetDeferralHistoryRequest
+	52,  // 129: loanbilling.v1.LoanBillingService.WriteOffLoan:input_type -> loanbilling.v1.WriteOffLoanRequest
+	54,  // 130: loanbilling.v1.LoanBillingService.GetWriteOff:input_type -> loanbilling.v1.GetWriteOffRequest
+	17,  // 131: loanbilling.v1.LoanBillingService.GetOutstanding:output_type -> loanbilling.v1.GetOutstandingResponse
+	19,  // 132: loanbilling.v1.LoanBillingService.IsDelinquent:output_type -> loanbilling.v1.IsDelinquentResponse
+	22,  // 133: loanbilling.v1.LoanBillingService.GetDelinquencyHistory:output_type -> loanbilling.v1.GetDelinquencyHistoryResponse
+	24,  // 134: loanbilling.v1.LoanBillingService.MakePayment:output_type -> loanbilling.v1.MakePaymentResponse
+	26,  // 135: loanbilling.v1.LoanBillingService.ReversePayment:output_type -> loanbilling.v1.ReversePaymentResponse
+	28,  // 136: loanbilling.v1.LoanBillingService.CreateLoan:output_type -> loanbilling.v1.CreateLoanResponse
+	30,  // 137: loanbilling.v1.LoanBillingService.GetLoan:output_type -> loanbilling.v1.GetLoanResponse
+	32,  // 138: loanbilling.v1.LoanBillingService.GetBillingSchedule:output_type -> loanbilling.v1.GetBillingScheduleResponse
+	35,  // 139: loanbilling.v1.LoanBillingService.GetPayoffQuote:output_type -> loanbilling.v1.GetPayoffQuoteResponse
+	37,  // 140: loanbilling.v1.LoanBillingService.SettleLoan:output_type -> loanbilling.v1.SettleLoanResponse
+	40,  // 141: loanbilling.v1.LoanBillingService.GetAging:output_type -> loanbilling.v1.GetAgingResponse
+	43,  // 142: loanbilling.v1.LoanBillingService.RestructureLoan:output_type -> loanbilling.v1.RestructureLoanResponse
+	45,  // 143: loanbilling.v1.LoanBillingService.GetLoanTermsHistory:output_type -> loanbilling.v1.GetLoanTermsHistoryResponse
+	48,  // 144: loanbilling.v1.LoanBillingService.DeferInstallments:output_type -> loanbilling.v1.DeferInstallmentsResponse
+	50,  // 145: loanbilling.v1.LoanBillingService.GetDeferralHistory:output_type -> loanbilling.v1.GetDeferralHistoryResponse
+	53,  // 146: loanbilling.v1.LoanBillingService.WriteOffLoan:output_type -> loanbilling.v1.WriteOffLoanResponse
+	55,  // 147: loanbilling.v1.LoanBillingService.GetWriteOff:output_type -> loanbilling.v1.GetWriteOffResponse
+	131, // [131:148] is the sub-list for method output_type
+	114, // [114:131] is the sub-list for method input_type
+	114, // [114:114] is the sub-list for extension type_name
+	114, // [114:114] is the sub-list for extension extendee
+	0,   // [0:114] is the sub-list for field type_name
+}
+
+func init() { file_loanbilling_v1_loanbilling_proto_init() }
+func file_loanbilling_v1_loanbilling_proto_init() {
+	if File_loanbilling_v1_loanbilling_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Loan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*DelinquencyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*DelinquencyStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Billing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOutstandingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOutstandingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*IsDelinquentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*IsDelinquentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DelinquencyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDelinquencyHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDelinquencyHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*MakePaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*MakePaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ReversePaymentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*ReversePaymentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBillingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -470,8 +5119,8 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 				return nil
 			}
 		}
-		file_loanbilling_v1_loanbilling_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOutstandingResponse); i {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetBillingScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -482,8 +5131,8 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 				return nil
 			}
 		}
-		file_loanbilling_v1_loanbilling_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*IsDelinquentRequest); i {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*PayoffQuote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -494,8 +5143,8 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 				return nil
 			}
 		}
-		file_loanbilling_v1_loanbilling_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*IsDelinquentResponse); i {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPayoffQuoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -506,8 +5155,8 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 				return nil
 			}
 		}
-		file_loanbilling_v1_loanbilling_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*MakePaymentRequest); i {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPayoffQuoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -518,8 +5167,236 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 				return nil
 			}
 		}
-		file_loanbilling_v1_loanbilling_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*MakePaymentResponse); i {
+		file_loanbilling_v1_loanbilling_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SettleLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*SettleLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*Aging); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAgingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAgingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*LoanTerms); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*RestructureLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*RestructureLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanTermsHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLoanTermsHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*DeferralEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DeferInstallmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DeferInstallmentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeferralHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDeferralHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteOff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteOffLoanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*WriteOffLoanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWriteOffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_loanbilling_v1_loanbilling_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetWriteOffResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -536,13 +5413,14 @@ func file_loanbilling_v1_loanbilling_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_loanbilling_v1_loanbilling_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      10,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_loanbilling_v1_loanbilling_proto_goTypes,
 		DependencyIndexes: file_loanbilling_v1_loanbilling_proto_depIdxs,
+		EnumInfos:         file_loanbilling_v1_loanbilling_proto_enumTypes,
 		MessageInfos:      file_loanbilling_v1_loanbilling_proto_msgTypes,
 	}.Build()
 	File_loanbilling_v1_loanbilling_proto = out.File
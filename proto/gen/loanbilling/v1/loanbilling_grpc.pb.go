@@ -19,9 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoanBillingService_GetOutstanding_FullMethodName = "/loanbilling.v1.LoanBillingService/GetOutstanding"
-	LoanBillingService_IsDelinquent_FullMethodName   = "/loanbilling.v1.LoanBillingService/IsDelinquent"
-	LoanBillingService_MakePayment_FullMethodName    = "/loanbilling.v1.LoanBillingService/MakePayment"
+	LoanBillingService_GetOutstanding_FullMethodName        = "/loanbilling.v1.LoanBillingService/GetOutstanding"
+	LoanBillingService_IsDelinquent_FullMethodName          = "/loanbilling.v1.LoanBillingService/IsDelinquent"
+	LoanBillingService_GetDelinquencyHistory_FullMethodName = "/loanbilling.v1.LoanBillingService/GetDelinquencyHistory"
+	LoanBillingService_MakePayment_FullMethodName           = "/loanbilling.v1.LoanBillingService/MakePayment"
+	LoanBillingService_ReversePayment_FullMethodName        = "/loanbilling.v1.LoanBillingService/ReversePayment"
+	LoanBillingService_CreateLoan_FullMethodName            = "/loanbilling.v1.LoanBillingService/CreateLoan"
+	LoanBillingService_GetLoan_FullMethodName               = "/loanbilling.v1.LoanBillingService/GetLoan"
+	LoanBillingService_GetBillingSchedule_FullMethodName    = "/loanbilling.v1.LoanBillingService/GetBillingSchedule"
+	LoanBillingService_GetPayoffQuote_FullMethodName        = "/loanbilling.v1.LoanBillingService/GetPayoffQuote"
+	LoanBillingService_SettleLoan_FullMethodName            = "/loanbilling.v1.LoanBillingService/SettleLoan"
+	LoanBillingService_GetAging_FullMethodName              = "/loanbilling.v1.LoanBillingService/GetAging"
+	LoanBillingService_RestructureLoan_FullMethodName       = "/loanbilling.v1.LoanBillingService/RestructureLoan"
+	LoanBillingService_GetLoanTermsHistory_FullMethodName   = "/loanbilling.v1.LoanBillingService/GetLoanTermsHistory"
+	LoanBillingService_DeferInstallments_FullMethodName     = "/loanbilling.v1.LoanBillingService/DeferInstallments"
+	LoanBillingService_GetDeferralHistory_FullMethodName    = "/loanbilling.v1.LoanBillingService/GetDeferralHistory"
+	LoanBillingService_WriteOffLoan_FullMethodName          = "/loanbilling.v1.LoanBillingService/WriteOffLoan"
+	LoanBillingService_GetWriteOff_FullMethodName           = "/loanbilling.v1.LoanBillingService/GetWriteOff"
 )
 
 // LoanBillingServiceClient is the client API for LoanBillingService service.
@@ -32,8 +46,36 @@ type LoanBillingServiceClient interface {
 	GetOutstanding(ctx context.Context, in *GetOutstandingRequest, opts ...grpc.CallOption) (*GetOutstandingResponse, error)
 	// check if a loan account is delinquent
 	IsDelinquent(ctx context.Context, in *IsDelinquentRequest, opts ...grpc.CallOption) (*IsDelinquentResponse, error)
+	// list every time a loan became delinquent or got cured
+	GetDelinquencyHistory(ctx context.Context, in *GetDelinquencyHistoryRequest, opts ...grpc.CallOption) (*GetDelinquencyHistoryResponse, error)
 	// make repayment to a loan account
 	MakePayment(ctx context.Context, in *MakePaymentRequest, opts ...grpc.CallOption) (*MakePaymentResponse, error)
+	// take a payment back (a bounced transfer, a chargeback), the payment is kept as reversed
+	ReversePayment(ctx context.Context, in *ReversePaymentRequest, opts ...grpc.CallOption) (*ReversePaymentResponse, error)
+	// bookkeep a loan that has been disbursed
+	CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error)
+	// get a loan with its delinquency status and payments
+	GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error)
+	// get the billing schedule of a loan
+	GetBillingSchedule(ctx context.Context, in *GetBillingScheduleRequest, opts ...grpc.CallOption) (*GetBillingScheduleResponse, error)
+	// quote how much is needed to close a loan at a given date
+	GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error)
+	// close a loan early by paying the exact payoff amount
+	SettleLoan(ctx context.Context, in *SettleLoanRequest, opts ...grpc.CallOption) (*SettleLoanResponse, error)
+	// get the days past due and the delinquency buckets of a loan at a given date
+	GetAging(ctx context.Context, in *GetAgingRequest, opts ...grpc.CallOption) (*GetAgingResponse, error)
+	// reschedule what is left of a loan over a new term count and rate, the old terms are kept as a superseded version
+	RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error)
+	// list every version of the terms of a loan
+	GetLoanTermsHistory(ctx context.Context, in *GetLoanTermsHistoryRequest, opts ...grpc.CallOption) (*GetLoanTermsHistoryResponse, error)
+	// give a loan a payment holiday, the deferred installments are due later
+	DeferInstallments(ctx context.Context, in *DeferInstallmentsRequest, opts ...grpc.CallOption) (*DeferInstallmentsResponse, error)
+	// list every payment holiday given to a loan
+	GetDeferralHistory(ctx context.Context, in *GetDeferralHistoryRequest, opts ...grpc.CallOption) (*GetDeferralHistoryResponse, error)
+	// charge off a loan nobody expects to be repaid anymore, its payments are recoveries from then on
+	WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error)
+	// get what has been written off of a loan and what has been recovered of it since
+	GetWriteOff(ctx context.Context, in *GetWriteOffRequest, opts ...grpc.CallOption) (*GetWriteOffResponse, error)
 }
 
 type loanBillingServiceClient struct {
@@ -64,6 +106,16 @@ func (c *loanBillingServiceClient) IsDelinquent(ctx context.Context, in *IsDelin
 	return out, nil
 }
 
+func (c *loanBillingServiceClient) GetDelinquencyHistory(ctx context.Context, in *GetDelinquencyHistoryRequest, opts ...grpc.CallOption) (*GetDelinquencyHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDelinquencyHistoryResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetDelinquencyHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loanBillingServiceClient) MakePayment(ctx context.Context, in *MakePaymentRequest, opts ...grpc.CallOption) (*MakePaymentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MakePaymentResponse)
@@ -74,6 +126,136 @@ func (c *loanBillingServiceClient) MakePayment(ctx context.Context, in *MakePaym
 	return out, nil
 }
 
+func (c *loanBillingServiceClient) ReversePayment(ctx context.Context, in *ReversePaymentRequest, opts ...grpc.CallOption) (*ReversePaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReversePaymentResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_ReversePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) CreateLoan(ctx context.Context, in *CreateLoanRequest, opts ...grpc.CallOption) (*CreateLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLoanResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_CreateLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetLoan(ctx context.Context, in *GetLoanRequest, opts ...grpc.CallOption) (*GetLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoanResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetBillingSchedule(ctx context.Context, in *GetBillingScheduleRequest, opts ...grpc.CallOption) (*GetBillingScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBillingScheduleResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetBillingSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetPayoffQuote(ctx context.Context, in *GetPayoffQuoteRequest, opts ...grpc.CallOption) (*GetPayoffQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayoffQuoteResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetPayoffQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) SettleLoan(ctx context.Context, in *SettleLoanRequest, opts ...grpc.CallOption) (*SettleLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleLoanResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_SettleLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetAging(ctx context.Context, in *GetAgingRequest, opts ...grpc.CallOption) (*GetAgingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAgingResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetAging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) RestructureLoan(ctx context.Context, in *RestructureLoanRequest, opts ...grpc.CallOption) (*RestructureLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestructureLoanResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_RestructureLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetLoanTermsHistory(ctx context.Context, in *GetLoanTermsHistoryRequest, opts ...grpc.CallOption) (*GetLoanTermsHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLoanTermsHistoryResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetLoanTermsHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) DeferInstallments(ctx context.Context, in *DeferInstallmentsRequest, opts ...grpc.CallOption) (*DeferInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeferInstallmentsResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_DeferInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetDeferralHistory(ctx context.Context, in *GetDeferralHistoryRequest, opts ...grpc.CallOption) (*GetDeferralHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeferralHistoryResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetDeferralHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) WriteOffLoan(ctx context.Context, in *WriteOffLoanRequest, opts ...grpc.CallOption) (*WriteOffLoanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WriteOffLoanResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_WriteOffLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanBillingServiceClient) GetWriteOff(ctx context.Context, in *GetWriteOffRequest, opts ...grpc.CallOption) (*GetWriteOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWriteOffResponse)
+	err := c.cc.Invoke(ctx, LoanBillingService_GetWriteOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanBillingServiceServer is the server API for LoanBillingService service.
 // All implementations must embed UnimplementedLoanBillingServiceServer
 // for forward compatibility.
@@ -82,8 +264,36 @@ type LoanBillingServiceServer interface {
 	GetOutstanding(context.Context, *GetOutstandingRequest) (*GetOutstandingResponse, error)
 	// check if a loan account is delinquent
 	IsDelinquent(context.Context, *IsDelinquentRequest) (*IsDelinquentResponse, error)
+	// list every time a loan became delinquent or got cured
+	GetDelinquencyHistory(context.Context, *GetDelinquencyHistoryRequest) (*GetDelinquencyHistoryResponse, error)
 	// make repayment to a loan account
 	MakePayment(context.Context, *MakePaymentRequest) (*MakePaymentResponse, error)
+	// take a payment back (a bounced transfer, a chargeback), the payment is kept as reversed
+	ReversePayment(context.Context, *ReversePaymentRequest) (*ReversePaymentResponse, error)
+	// bookkeep a loan that has been disbursed
+	CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error)
+	// get a loan with its delinquency status and payments
+	GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error)
+	// get the billing schedule of a loan
+	GetBillingSchedule(context.Context, *GetBillingScheduleRequest) (*GetBillingScheduleResponse, error)
+	// quote how much is needed to close a loan at a given date
+	GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error)
+	// close a loan early by paying the exact payoff amount
+	SettleLoan(context.Context, *SettleLoanRequest) (*SettleLoanResponse, error)
+	// get the days past due and the delinquency buckets of a loan at a given date
+	GetAging(context.Context, *GetAgingRequest) (*GetAgingResponse, error)
+	// reschedule what is left of a loan over a new term count and rate, the old terms are kept as a superseded version
+	RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error)
+	// list every version of the terms of a loan
+	GetLoanTermsHistory(context.Context, *GetLoanTermsHistoryRequest) (*GetLoanTermsHistoryResponse, error)
+	// give a loan a payment holiday, the deferred installments are due later
+	DeferInstallments(context.Context, *DeferInstallmentsRequest) (*DeferInstallmentsResponse, error)
+	// list every payment holiday given to a loan
+	GetDeferralHistory(context.Context, *GetDeferralHistoryRequest) (*GetDeferralHistoryResponse, error)
+	// charge off a loan nobody expects to be repaid anymore, its payments are recoveries from then on
+	WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error)
+	// get what has been written off of a loan and what has been recovered of it since
+	GetWriteOff(context.Context, *GetWriteOffRequest) (*GetWriteOffResponse, error)
 	mustEmbedUnimplementedLoanBillingServiceServer()
 }
 
@@ -100,9 +310,51 @@ func (UnimplementedLoanBillingServiceServer) GetOutstanding(context.Context, *Ge
 func (UnimplementedLoanBillingServiceServer) IsDelinquent(context.Context, *IsDelinquentRequest) (*IsDelinquentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsDelinquent not implemented")
 }
+func (UnimplementedLoanBillingServiceServer) GetDelinquencyHistory(context.Context, *GetDelinquencyHistoryRequest) (*GetDelinquencyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelinquencyHistory not implemented")
+}
 func (UnimplementedLoanBillingServiceServer) MakePayment(context.Context, *MakePaymentRequest) (*MakePaymentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakePayment not implemented")
 }
+func (UnimplementedLoanBillingServiceServer) ReversePayment(context.Context, *ReversePaymentRequest) (*ReversePaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversePayment not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) CreateLoan(context.Context, *CreateLoanRequest) (*CreateLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLoan not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetLoan(context.Context, *GetLoanRequest) (*GetLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoan not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetBillingSchedule(context.Context, *GetBillingScheduleRequest) (*GetBillingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBillingSchedule not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetPayoffQuote(context.Context, *GetPayoffQuoteRequest) (*GetPayoffQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayoffQuote not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) SettleLoan(context.Context, *SettleLoanRequest) (*SettleLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleLoan not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetAging(context.Context, *GetAgingRequest) (*GetAgingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAging not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) RestructureLoan(context.Context, *RestructureLoanRequest) (*RestructureLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestructureLoan not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetLoanTermsHistory(context.Context, *GetLoanTermsHistoryRequest) (*GetLoanTermsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoanTermsHistory not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) DeferInstallments(context.Context, *DeferInstallmentsRequest) (*DeferInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferInstallments not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetDeferralHistory(context.Context, *GetDeferralHistoryRequest) (*GetDeferralHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeferralHistory not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) WriteOffLoan(context.Context, *WriteOffLoanRequest) (*WriteOffLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteOffLoan not implemented")
+}
+func (UnimplementedLoanBillingServiceServer) GetWriteOff(context.Context, *GetWriteOffRequest) (*GetWriteOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWriteOff not implemented")
+}
 func (UnimplementedLoanBillingServiceServer) mustEmbedUnimplementedLoanBillingServiceServer() {}
 func (UnimplementedLoanBillingServiceServer) testEmbeddedByValue()                            {}
 
@@ -160,6 +412,24 @@ func _LoanBillingService_IsDelinquent_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanBillingService_GetDelinquencyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelinquencyHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetDelinquencyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetDelinquencyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetDelinquencyHistory(ctx, req.(*GetDelinquencyHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoanBillingService_MakePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MakePaymentRequest)
 	if err := dec(in); err != nil {
@@ -178,6 +448,240 @@ func _LoanBillingService_MakePayment_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanBillingService_ReversePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReversePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).ReversePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_ReversePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).ReversePayment(ctx, req.(*ReversePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_CreateLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).CreateLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_CreateLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).CreateLoan(ctx, req.(*CreateLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetLoan(ctx, req.(*GetLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetBillingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBillingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetBillingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetBillingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetBillingSchedule(ctx, req.(*GetBillingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetPayoffQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoffQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetPayoffQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetPayoffQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetPayoffQuote(ctx, req.(*GetPayoffQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_SettleLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).SettleLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_SettleLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).SettleLoan(ctx, req.(*SettleLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetAging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetAging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetAging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetAging(ctx, req.(*GetAgingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_RestructureLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestructureLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).RestructureLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_RestructureLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).RestructureLoan(ctx, req.(*RestructureLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetLoanTermsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanTermsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetLoanTermsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetLoanTermsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetLoanTermsHistory(ctx, req.(*GetLoanTermsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_DeferInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeferInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).DeferInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_DeferInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).DeferInstallments(ctx, req.(*DeferInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetDeferralHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeferralHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetDeferralHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetDeferralHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetDeferralHistory(ctx, req.(*GetDeferralHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_WriteOffLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteOffLoanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).WriteOffLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_WriteOffLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).WriteOffLoan(ctx, req.(*WriteOffLoanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanBillingService_GetWriteOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWriteOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanBillingServiceServer).GetWriteOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanBillingService_GetWriteOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanBillingServiceServer).GetWriteOff(ctx, req.(*GetWriteOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoanBillingService_ServiceDesc is the grpc.ServiceDesc for LoanBillingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,10 +697,66 @@ var LoanBillingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsDelinquent",
 			Handler:    _LoanBillingService_IsDelinquent_Handler,
 		},
+		{
+			MethodName: "GetDelinquencyHistory",
+			Handler:    _LoanBillingService_GetDelinquencyHistory_Handler,
+		},
 		{
 			MethodName: "MakePayment",
 			Handler:    _LoanBillingService_MakePayment_Handler,
 		},
+		{
+			MethodName: "ReversePayment",
+			Handler:    _LoanBillingService_ReversePayment_Handler,
+		},
+		{
+			MethodName: "CreateLoan",
+			Handler:    _LoanBillingService_CreateLoan_Handler,
+		},
+		{
+			MethodName: "GetLoan",
+			Handler:    _LoanBillingService_GetLoan_Handler,
+		},
+		{
+			MethodName: "GetBillingSchedule",
+			Handler:    _LoanBillingService_GetBillingSchedule_Handler,
+		},
+		{
+			MethodName: "GetPayoffQuote",
+			Handler:    _LoanBillingService_GetPayoffQuote_Handler,
+		},
+		{
+			MethodName: "SettleLoan",
+			Handler:    _LoanBillingService_SettleLoan_Handler,
+		},
+		{
+			MethodName: "GetAging",
+			Handler:    _LoanBillingService_GetAging_Handler,
+		},
+		{
+			MethodName: "RestructureLoan",
+			Handler:    _LoanBillingService_RestructureLoan_Handler,
+		},
+		{
+			MethodName: "GetLoanTermsHistory",
+			Handler:    _LoanBillingService_GetLoanTermsHistory_Handler,
+		},
+		{
+			MethodName: "DeferInstallments",
+			Handler:    _LoanBillingService_DeferInstallments_Handler,
+		},
+		{
+			MethodName: "GetDeferralHistory",
+			Handler:    _LoanBillingService_GetDeferralHistory_Handler,
+		},
+		{
+			MethodName: "WriteOffLoan",
+			Handler:    _LoanBillingService_WriteOffLoan_Handler,
+		},
+		{
+			MethodName: "GetWriteOff",
+			Handler:    _LoanBillingService_GetWriteOff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loanbilling/v1/loanbilling.proto",